@@ -0,0 +1,200 @@
+// Package csrgraph provides an immutable graph data structure over the integers 0 to
+// n-1, stored in the compressed sparse row format.
+package csrgraph
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+	"strings"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/tuples"
+)
+
+// CSRGraph is an immutable graph data structure over the vertices 0 to n-1. The
+// neighbors of every vertex are stored contiguously and sorted in a single slice,
+// with an offset per vertex marking where they start, which keeps the graph compact
+// and its traversals cache friendly. The graph can be directed or undirected.
+type CSRGraph[N constraints.Number] struct {
+	offsets    []int // neighbors of v are targets[offsets[v]:offsets[v+1]]
+	targets    []int
+	weights    []N
+	isDirected bool
+}
+
+// New creates a new graph with the vertices 0 to n-1 and the given edges. If the graph
+// is undirected, every edge is added in both directions. If an edge appears more than
+// once, the last weight is kept. New panics if an edge has an end outside of the
+// range 0 to n-1.
+func New[N constraints.Number](n int, isDirected bool, edges ...tuples.Edge[int, N]) *CSRGraph[N] {
+	type arc struct {
+		src, dst int
+		weight   N
+	}
+	arcs := make([]arc, 0, 2*len(edges))
+	for _, e := range edges {
+		if e.Src() < 0 || e.Src() >= n || e.Dst() < 0 || e.Dst() >= n {
+			panic("vertex out of range")
+		}
+		arcs = append(arcs, arc{e.Src(), e.Dst(), e.Weight()})
+		if !isDirected && e.Src() != e.Dst() {
+			arcs = append(arcs, arc{e.Dst(), e.Src(), e.Weight()})
+		}
+	}
+
+	// a stable sort keeps repeated edges in order, so the last one can be kept
+	slices.SortStableFunc(arcs, func(a, b arc) int {
+		if c := cmp.Compare(a.src, b.src); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.dst, b.dst)
+	})
+
+	g := &CSRGraph[N]{
+		offsets:    make([]int, n+1),
+		targets:    make([]int, 0, len(arcs)),
+		weights:    make([]N, 0, len(arcs)),
+		isDirected: isDirected,
+	}
+	for i, a := range arcs {
+		if i+1 < len(arcs) && arcs[i+1].src == a.src && arcs[i+1].dst == a.dst {
+			continue
+		}
+		g.targets = append(g.targets, a.dst)
+		g.weights = append(g.weights, a.weight)
+		g.offsets[a.src+1]++
+	}
+	for v := range n {
+		g.offsets[v+1] += g.offsets[v]
+	}
+	return g
+}
+
+// FromGraph creates a new graph with the vertices 0 to n-1 and the edges yielded by
+// edges, such as the Edges of another graph.
+func FromGraph[N constraints.Number](n int, isDirected bool, edges iter.Seq[tuples.Edge[int, N]]) *CSRGraph[N] {
+	return New(n, isDirected, slices.Collect(edges)...)
+}
+
+// HasVertex returns true if the vertex is in the range 0 to n-1 and false otherwise.
+func (g CSRGraph[N]) HasVertex(vertex int) bool {
+	return vertex >= 0 && vertex < g.VertexCount()
+}
+
+// Degree returns the out-degree of a vertex in a directed graph and the degree of a
+// vertex in an undirected graph. It returns 0 for vertices outside of the graph.
+func (g CSRGraph[N]) Degree(vertex int) int {
+	if !g.HasVertex(vertex) {
+		return 0
+	}
+	return g.offsets[vertex+1] - g.offsets[vertex]
+}
+
+// HasEdge returns true if there is an edge between two vertices, src and dst, and
+// false otherwise. If a weight is provided, it also checks that the edge has that
+// weight.
+func (g CSRGraph[N]) HasEdge(src, dst int, weight ...N) bool {
+	w, ok := g.EdgeWeight(src, dst)
+	return ok && (len(weight) == 0 || w == weight[0])
+}
+
+// EdgeWeight returns the weight of the edge between two vertices, src and dst, and
+// true, or false if the edge doesn't exist. It runs a binary search over the
+// neighbors of src.
+func (g CSRGraph[N]) EdgeWeight(src, dst int) (N, bool) {
+	if !g.HasVertex(src) {
+		return 0, false
+	}
+	lo, hi := g.offsets[src], g.offsets[src+1]
+	i, ok := slices.BinarySearch(g.targets[lo:hi], dst)
+	if !ok {
+		return 0, false
+	}
+	return g.weights[lo+i], true
+}
+
+// VertexCount returns the number of vertices in the graph.
+func (g CSRGraph[N]) VertexCount() int {
+	return len(g.offsets) - 1
+}
+
+// EdgeCount returns the number of edges in the graph, counting every edge of an
+// undirected graph once.
+func (g CSRGraph[N]) EdgeCount() int {
+	if g.isDirected {
+		return len(g.targets)
+	}
+	count := 0
+	for range g.Edges() {
+		count++
+	}
+	return count
+}
+
+// IsDirected returns true if the graph is directed and false otherwise.
+func (g CSRGraph[N]) IsDirected() bool {
+	return g.isDirected
+}
+
+// Edges returns an iter.Seq[tuples.Edge[int, N]] over the edges of the graph. In
+// undirected graphs every edge is yielded once, from its smaller end.
+func (g CSRGraph[N]) Edges() iter.Seq[tuples.Edge[int, N]] {
+	return func(yield func(tuples.Edge[int, N]) bool) {
+		for src := range g.VertexCount() {
+			for i := g.offsets[src]; i < g.offsets[src+1]; i++ {
+				dst := g.targets[i]
+				if !g.isDirected && dst < src {
+					continue
+				}
+				if !yield(tuples.NewEdge(src, dst, g.weights[i])) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Vertices returns an iter.Seq[int] over the vertices 0 to n-1.
+func (g CSRGraph[N]) Vertices() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for v := range g.VertexCount() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbors returns an iter.Seq2[int, N] over the neighbors of a vertex along with
+// the weights of the edges, in increasing order. If the vertex is outside of the
+// graph, an empty sequence is returned.
+func (g CSRGraph[N]) Neighbors(vertex int) iter.Seq2[int, N] {
+	return func(yield func(int, N) bool) {
+		if !g.HasVertex(vertex) {
+			return
+		}
+		for i := g.offsets[vertex]; i < g.offsets[vertex+1]; i++ {
+			if !yield(g.targets[i], g.weights[i]) {
+				return
+			}
+		}
+	}
+}
+
+// String returns a string representation of the graph.
+func (g CSRGraph[N]) String() string {
+	var sb strings.Builder
+	sb.WriteString("G[")
+	first := true
+	for e := range g.Edges() {
+		if first {
+			first = false
+		} else {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(e.String())
+	}
+	sb.WriteString("]")
+	return sb.String()
+}
@@ -0,0 +1,65 @@
+package csrgraph_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/elordeiro/goext/containers/csrgraph"
+	"github.com/elordeiro/goext/containers/graph"
+	"github.com/elordeiro/goext/containers/hashgraph"
+	"github.com/elordeiro/goext/containers/tuples"
+	"github.com/elordeiro/goext/seq2s"
+	"github.com/elordeiro/goext/seqs"
+)
+
+func TestCSRGraph(t *testing.T) {
+	g := csrgraph.New(5, false,
+		tuples.NewEdge(3, 1, 2),
+		tuples.NewEdge(0, 1, 1),
+		tuples.NewEdge(1, 2, 3),
+		tuples.NewEdge(2, 2, 1),
+		tuples.NewEdge(1, 3, 7),
+	)
+
+	if got := g.VertexCount(); got != 5 {
+		t.Errorf("VertexCount() = %v, want 5", got)
+	}
+	if got := g.EdgeCount(); got != 4 {
+		t.Errorf("EdgeCount() = %v, want 4", got)
+	}
+	if !g.HasEdge(3, 1, 7) || !g.HasEdge(1, 0) || g.HasEdge(0, 4) || g.HasEdge(9, 0) {
+		t.Errorf("HasEdge() does not match the edges")
+	}
+	if got := slices.Collect(seq2s.Keys(g.Neighbors(1))); !slices.Equal(got, []int{0, 2, 3}) {
+		t.Errorf("Neighbors(1) = %v, want [0 2 3]", got)
+	}
+	if got := g.Degree(4); got != 0 {
+		t.Errorf("Degree(4) = %v, want 0", got)
+	}
+	if got, want := g.String(), "G[(0->1 1) (1->2 3) (1->3 7) (2->2 1)]"; got != want {
+		t.Errorf("String() = %v, want %v", got, want)
+	}
+}
+
+func TestCSRGraphFromGraph(t *testing.T) {
+	h := hashgraph.New[int, int](true)
+	h.AddEdge(0, 1, 4)
+	h.AddEdge(0, 2, 1)
+	h.AddEdge(2, 1, 2)
+	h.AddEdge(1, 3, 1)
+
+	g := csrgraph.FromGraph(4, true, h.Edges())
+	if got := g.EdgeCount(); got != 4 {
+		t.Errorf("EdgeCount() = %v, want 4", got)
+	}
+	cost := 0
+	for e := range graph.Dijkstra(g, 0, graph.BaseCaseOption(func(v int) bool { return v == 3 })) {
+		cost += e.Weight()
+	}
+	if cost != 4 {
+		t.Errorf("Dijkstra(0, 3) cost = %v, want 4", cost)
+	}
+	if got := seqs.Len(g.Edges()); got != seqs.Len(h.Edges()) {
+		t.Errorf("len(Edges()) = %v, want %v", got, seqs.Len(h.Edges()))
+	}
+}
@@ -0,0 +1,186 @@
+package graph
+
+import (
+	"iter"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/tuples"
+	"github.com/elordeiro/goext/containers/vector"
+	extMath "github.com/elordeiro/goext/math"
+)
+
+// AllPairs holds the shortest paths between every pair of vertices of a graph, as
+// computed by FloydWarshall or Johnson.
+type AllPairs[V comparable, N constraints.Number] struct {
+	index    map[V]int
+	vertices []V
+	dist     [][]N
+	pred     [][]int // predecessor of j on the shortest path from i to j, -1 if none
+	last     [][]N   // weight of the last edge on the shortest path from i to j
+}
+
+// newAllPairs returns an AllPairs for the vertices of g with no paths recorded.
+func newAllPairs[V comparable, N constraints.Number](g Graph[V, N]) *AllPairs[V, N] {
+	n := g.VertexCount()
+	ap := &AllPairs[V, N]{
+		index:    make(map[V]int, n),
+		vertices: make([]V, 0, n),
+		dist:     make([][]N, n),
+		pred:     make([][]int, n),
+		last:     make([][]N, n),
+	}
+	for v := range g.Vertices() {
+		ap.index[v] = len(ap.vertices)
+		ap.vertices = append(ap.vertices, v)
+	}
+	for i := range n {
+		ap.dist[i] = make([]N, n)
+		ap.pred[i] = make([]int, n)
+		ap.last[i] = make([]N, n)
+		for j := range n {
+			ap.pred[i][j] = -1
+		}
+	}
+	return ap
+}
+
+// reachable returns true if there is a path from the vertex at index i to the
+// vertex at index j.
+func (ap *AllPairs[V, N]) reachable(i, j int) bool {
+	return i == j || ap.pred[i][j] != -1
+}
+
+// Reachable returns true if there is a path from the vertex src to the vertex dst
+// and false otherwise. A vertex is always reachable from itself.
+func (ap *AllPairs[V, N]) Reachable(src, dst V) bool {
+	i, ok1 := ap.index[src]
+	j, ok2 := ap.index[dst]
+	return ok1 && ok2 && ap.reachable(i, j)
+}
+
+// Dist returns the length of the shortest path from the vertex src to the vertex dst.
+// If there is no such path, the maximum value of N is returned.
+func (ap *AllPairs[V, N]) Dist(src, dst V) N {
+	if !ap.Reachable(src, dst) {
+		return extMath.Inf[N]()
+	}
+	return ap.dist[ap.index[src]][ap.index[dst]]
+}
+
+// Path returns an iter.Seq[tuples.Edge[V, N]] over the edges of the shortest path
+// from the vertex src to the vertex dst. If no path exists, or if src and dst are
+// the same vertex, an empty sequence is returned.
+func (ap *AllPairs[V, N]) Path(src, dst V) iter.Seq[tuples.Edge[V, N]] {
+	vec := vector.New[tuples.Edge[V, N]]()
+	if ap.Reachable(src, dst) {
+		i, j := ap.index[src], ap.index[dst]
+		for j != i {
+			p := ap.pred[i][j]
+			vec.Push(tuples.NewEdge(ap.vertices[p], ap.vertices[j], ap.last[i][j]))
+			j = p
+		}
+	}
+	return vec.Backwards()
+}
+
+// FloydWarshall returns the shortest paths between every pair of vertices of the
+// graph g. Edge weights may be negative. If the graph has a negative cycle, a nil
+// result and a NegativeCycleError carrying the cycle are returned. The algorithm
+// runs in O(V^3) time, which makes it a good fit for small or dense graphs.
+func FloydWarshall[V comparable, N constraints.Number](g Graph[V, N]) (*AllPairs[V, N], error) {
+	ap := newAllPairs(g)
+	for i, src := range ap.vertices {
+		for dst, w := range g.Neighbors(src) {
+			j := ap.index[dst]
+			if i == j && w >= 0 {
+				// only negative self loops can shorten the empty path
+				continue
+			}
+			if ap.pred[i][j] == -1 || w < ap.dist[i][j] {
+				ap.dist[i][j] = w
+				ap.pred[i][j] = i
+				ap.last[i][j] = w
+			}
+		}
+	}
+
+	n := len(ap.vertices)
+	for k := range n {
+		for i := range n {
+			if !ap.reachable(i, k) {
+				continue
+			}
+			for j := range n {
+				if !ap.reachable(k, j) || (i == k && j == k) {
+					continue
+				}
+				d := ap.dist[i][k] + ap.dist[k][j]
+				if !ap.reachable(i, j) || (i != j && d < ap.dist[i][j]) || (i == j && d < 0) {
+					ap.dist[i][j] = d
+					ap.pred[i][j] = ap.pred[k][j]
+					ap.last[i][j] = ap.last[k][j]
+				}
+			}
+		}
+	}
+
+	for i, v := range ap.vertices {
+		if ap.pred[i][i] != -1 && ap.dist[i][i] < 0 {
+			_, err := BellmanFord(g, v)
+			return nil, err
+		}
+	}
+	return ap, nil
+}
+
+// Johnson returns the shortest paths between every pair of vertices of the graph g.
+// Edge weights may be negative. The edges are first reweighted with the potentials
+// found by BellmanFord so that none is negative, and then Dijkstra is run from every
+// vertex. If the graph has a negative cycle, a nil result and a NegativeCycleError
+// carrying the cycle are returned. The algorithm runs in O(V E log V) time, which
+// makes it a better fit than FloydWarshall for large sparse graphs.
+func Johnson[V comparable, N constraints.Number](g Graph[V, N]) (*AllPairs[V, N], error) {
+	// starting every vertex at distance 0 is equivalent to adding a new source
+	// with an edge of weight 0 to every vertex
+	h := map[V]N{}
+	for v := range g.Vertices() {
+		h[v] = 0
+	}
+	h, _, err := relaxEdges(g, h, g.VertexCount())
+	if err != nil {
+		return nil, err
+	}
+
+	ap := newAllPairs(g)
+	rg := reweighted[V, N]{g, h}
+	for i, src := range ap.vertices {
+		dist, prev, _, _ := dijkstraAll(rg, src, setOptions[V]())
+		for dst, d := range dist {
+			j := ap.index[dst]
+			ap.dist[i][j] = d - h[src] + h[dst]
+			if p, ok := prev[dst]; ok {
+				u := p.Left()
+				ap.pred[i][j] = ap.index[u]
+				ap.last[i][j] = p.Right() - h[u] + h[dst]
+			}
+		}
+	}
+	return ap, nil
+}
+
+// reweighted is a Graph that wraps another graph and changes the weight of every
+// edge (u, v) from w to w + h[u] - h[v]. It is used by Johnson's algorithm.
+type reweighted[V comparable, N constraints.Number] struct {
+	Graph[V, N]
+	h map[V]N
+}
+
+func (g reweighted[V, N]) Neighbors(src V) iter.Seq2[V, N] {
+	return func(yield func(V, N) bool) {
+		for dst, w := range g.Graph.Neighbors(src) {
+			if !yield(dst, w+g.h[src]-g.h[dst]) {
+				return
+			}
+		}
+	}
+}
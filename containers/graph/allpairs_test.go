@@ -0,0 +1,103 @@
+package graph_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/elordeiro/goext/containers/graph"
+	"github.com/elordeiro/goext/containers/hashgraph"
+	"github.com/elordeiro/goext/containers/tuples"
+	extMath "github.com/elordeiro/goext/math"
+	"github.com/elordeiro/goext/seqs"
+	"github.com/elordeiro/goext/seqs/transform"
+)
+
+func TestAllPairs(t *testing.T) {
+	g := hashgraph.New[int, int](true)
+	g.AddEdge(1, 2, 3)
+	g.AddEdge(1, 3, 8)
+	g.AddEdge(1, 5, -4)
+	g.AddEdge(2, 4, 1)
+	g.AddEdge(2, 5, 7)
+	g.AddEdge(3, 2, 4)
+	g.AddEdge(4, 1, 2)
+	g.AddEdge(4, 3, -5)
+	g.AddEdge(5, 4, 6)
+	g.AddEdge(5, 5, 2)
+	g.AddVertex(6)
+
+	// distance matrix from CLRS, figure 25.4
+	want := [][]int{
+		{0, 1, -3, 2, -4},
+		{3, 0, -4, 1, -1},
+		{7, 4, 0, 5, 3},
+		{2, -1, -5, 0, -2},
+		{8, 5, 1, 6, 0},
+	}
+
+	funcs := []struct {
+		name string
+		f    func(graph.Graph[int, int]) (*graph.AllPairs[int, int], error)
+	}{
+		{"FloydWarshall", graph.FloydWarshall[int, int]},
+		{"Johnson", graph.Johnson[int, int]},
+	}
+
+	for _, fn := range funcs {
+		t.Run(fn.name, func(t *testing.T) {
+			ap, err := fn.f(g)
+			if err != nil {
+				t.Fatalf("%s(%v) error = %v, want nil", fn.name, g, err)
+			}
+
+			for i := range want {
+				for j := range want[i] {
+					src, dst := i+1, j+1
+					if got := ap.Dist(src, dst); got != want[i][j] {
+						t.Errorf("Dist(%v, %v) = %v, want %v", src, dst, got, want[i][j])
+					}
+
+					path := ap.Path(src, dst)
+					cost := transform.Reduce(path, func(acc int, e tuples.Edge[int, int]) int { return acc + e.Weight() })
+					if cost != want[i][j] {
+						t.Errorf("Path(%v, %v) = %v, cost %v, want %v", src, dst, seqs.String(path), cost, want[i][j])
+					}
+					prev := src
+					for e := range path {
+						if e.Src() != prev || !g.HasEdge(e.Src(), e.Dst(), e.Weight()) {
+							t.Errorf("Path(%v, %v) = %v, is not a path in %v", src, dst, seqs.String(path), g)
+							break
+						}
+						prev = e.Dst()
+					}
+					if prev != dst {
+						t.Errorf("Path(%v, %v) = %v, does not end in %v", src, dst, seqs.String(path), dst)
+					}
+				}
+			}
+
+			if ap.Reachable(1, 6) || ap.Dist(1, 6) != extMath.Inf[int]() || !seqs.IsEmpty(ap.Path(1, 6)) {
+				t.Errorf("Dist(1, 6) = %v, want unreachable", ap.Dist(1, 6))
+			}
+			if !ap.Reachable(6, 6) || ap.Dist(6, 6) != 0 {
+				t.Errorf("Dist(6, 6) = %v, want 0", ap.Dist(6, 6))
+			}
+		})
+	}
+}
+
+func TestAllPairsNegativeCycle(t *testing.T) {
+	g := hashgraph.New[int, float64](true)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 3, -2.5)
+	g.AddEdge(3, 4, 1)
+	g.AddEdge(4, 2, 1)
+	g.AddEdge(5, 1, 1)
+
+	if _, err := graph.FloydWarshall(g); !errors.As(err, &graph.NegativeCycleError[int, float64]{}) {
+		t.Errorf("FloydWarshall(%v) error = %v, want NegativeCycleError", g, err)
+	}
+	if _, err := graph.Johnson(g); !errors.As(err, &graph.NegativeCycleError[int, float64]{}) {
+		t.Errorf("Johnson(%v) error = %v, want NegativeCycleError", g, err)
+	}
+}
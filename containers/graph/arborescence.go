@@ -0,0 +1,163 @@
+package graph
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/skewheap"
+	"github.com/elordeiro/goext/containers/tuples"
+	"github.com/elordeiro/goext/containers/unionfind"
+	"github.com/elordeiro/goext/containers/vector"
+)
+
+// UnreachableError is returned when an algorithm requires every vertex of the graph
+// to be reachable from a root vertex and some are not.
+type UnreachableError[V comparable] struct {
+	Root     V
+	Vertices []V
+}
+
+func (e UnreachableError[V]) Error() string {
+	return fmt.Sprintf("vertices not reachable from %v: %v", e.Root, e.Vertices)
+}
+
+// Tarjan returns the minimum arborescence of the directed graph g rooted at the vertex
+// root, that is, the spanning tree of minimum weight in which every vertex can be
+// reached from the root. The algorithm returns an iter.Seq[tuples.Edge[V, N]] that
+// represents the arborescence. If some vertices cannot be reached from the root, no
+// spanning arborescence exists, and a nil sequence is returned along with an
+// UnreachableError listing them.
+// The algorithm used is Tarjan's O(E log V) version of the Chu-Liu-Edmonds algorithm,
+// which contracts cycles with a union find and keeps the incoming edges of every
+// vertex in a skew heap.
+func Tarjan[V comparable, N constraints.Number](g Graph[V, N], root V) (iter.Seq[tuples.Edge[V, N]], error) {
+	// only the vertices reachable from root take part in the arborescence
+	index := map[V]int{root: 0}
+	for e := range BFS(g, root) {
+		index[e.Dst()] = len(index)
+	}
+	if len(index) < g.VertexCount() {
+		unreachable := []V{}
+		for v := range g.Vertices() {
+			if _, ok := index[v]; !ok {
+				unreachable = append(unreachable, v)
+			}
+		}
+		return nil, UnreachableError[V]{Root: root, Vertices: unreachable}
+	}
+
+	n := len(index)
+	edges := []tuples.Edge[V, N]{}
+	heap := make([]*skewheap.SkewHeap[int, N], n)
+	for src, i := range index {
+		for dst, w := range g.Neighbors(src) {
+			j := index[dst]
+			if i == j || j == 0 {
+				continue
+			}
+			heap[j] = skewheap.Push(heap[j], w, len(edges))
+			edges = append(edges, tuples.NewEdge(src, dst, w))
+		}
+	}
+
+	// Every vertex starts as its own node. Contracting a cycle creates a new node,
+	// and the contracted nodes become its members, forming a forest of contractions
+	// that is used to expand the cycles once every node has an incoming edge.
+	uf := unionfind.New[int]()
+	node := make([]int, n)   // node of every union find root
+	parent := make([]int, n) // parent of every node in the forest of contractions
+	chosen := make([]int, n) // incoming edge chosen for every node
+	members := map[int][]int{}
+	for i := range n {
+		uf.MakeSet(i)
+		node[i], parent[i], chosen[i] = i, -1, -1
+	}
+
+	const unseen = -1
+	seen := make([]int, n)
+	for i := range seen {
+		seen[i] = unseen
+	}
+	seen[0] = 0
+	path := make([]int, 0, n)
+
+	for s := range n {
+		path = path[:0]
+		for u := uf.Find(s); seen[u] == unseen; {
+			// drop the edges that became internal to u after a contraction
+			for heap[u] != nil && uf.Find(index[edges[heap[u].Item].Src()]) == u {
+				heap[u] = skewheap.Pop(heap[u])
+			}
+
+			// every vertex is reachable from the root, so u always has an
+			// incoming edge from outside
+			top := heap[u]
+			skewheap.Update(top, -top.Cost())
+			heap[u] = skewheap.Pop(top)
+
+			chosen[node[u]] = top.Item
+			path = append(path, u)
+			seen[u] = s
+			u = uf.Find(index[edges[top.Item].Src()])
+			if seen[u] != s {
+				continue
+			}
+
+			// the chosen edges closed a cycle, contract it into a new node
+			c := len(parent)
+			parent = append(parent, -1)
+			chosen = append(chosen, -1)
+			var cycle *skewheap.SkewHeap[int, N]
+			for {
+				w := path[len(path)-1]
+				path = path[:len(path)-1]
+				cycle = skewheap.Merge(cycle, heap[w])
+				parent[node[w]] = c
+				members[c] = append(members[c], node[w])
+				if w == u {
+					break
+				}
+				uf.Union(u, w)
+			}
+			u = uf.Find(u)
+			node[u], heap[u], seen[u] = c, cycle, unseen
+		}
+	}
+
+	// Expand the contractions from the top. Every node of the forest receives an
+	// incoming edge: the edge it chose itself, unless an edge entering the cycle
+	// it belongs to goes into one of its vertices.
+	type entry struct{ node, edge int }
+	stack := []entry{}
+	for x := range parent {
+		if parent[x] == -1 && chosen[x] != -1 {
+			stack = append(stack, entry{x, chosen[x]})
+		}
+	}
+
+	arborescence := vector.New[tuples.Edge[V, N]]()
+	for len(stack) > 0 {
+		top := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if top.node < n {
+			arborescence.Push(edges[top.edge])
+			continue
+		}
+
+		// find the member of the cycle that holds the destination of the edge
+		m := index[edges[top.edge].Dst()]
+		for parent[m] != top.node {
+			m = parent[m]
+		}
+		for _, member := range members[top.node] {
+			if member == m {
+				stack = append(stack, entry{member, top.edge})
+			} else {
+				stack = append(stack, entry{member, chosen[member]})
+			}
+		}
+	}
+
+	return arborescence.Values(), nil
+}
@@ -0,0 +1,81 @@
+package graph_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/elordeiro/goext/containers/graph"
+	"github.com/elordeiro/goext/containers/hashgraph"
+	"github.com/elordeiro/goext/containers/set"
+	"github.com/elordeiro/goext/containers/tuples"
+	"github.com/elordeiro/goext/seqs"
+	"github.com/elordeiro/goext/seqs/transform"
+)
+
+func TestTarjanAgainstEdmonds(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	weight := func(seq func(func(tuples.Edge[int, int]) bool)) int {
+		return transform.Reduce(seq, func(acc int, e tuples.Edge[int, int]) int { return acc + e.Weight() })
+	}
+
+	for trial := range 200 {
+		n := 2 + r.IntN(12)
+		g := hashgraph.New[int, int](true)
+		for v := 1; v < n; v++ {
+			// a random tree rooted at 0 makes every vertex reachable
+			g.AddEdge(r.IntN(v), v, r.IntN(20)-5)
+		}
+		for range r.IntN(3 * n) {
+			g.AddEdge(r.IntN(n), r.IntN(n), r.IntN(20)-5)
+		}
+
+		got, err := graph.Tarjan(g, 0)
+		if err != nil {
+			t.Fatalf("trial %d: Tarjan(%v, 0) error = %v, want nil", trial, g, err)
+		}
+
+		// every vertex but the root has exactly one incoming edge, and the
+		// edges form a tree reachable from the root
+		in := set.New[int]()
+		tree := hashgraph.New[int, int](true)
+		tree.AddVertex(0)
+		for e := range got {
+			if !g.HasEdge(e.Src(), e.Dst(), e.Weight()) || e.Dst() == 0 || in.Contains(e.Dst()) {
+				t.Fatalf("trial %d: Tarjan(%v, 0) = %v, is not an arborescence", trial, g, seqs.String(got))
+			}
+			in.Add(e.Dst())
+			tree.AddEdge(e.Src(), e.Dst(), e.Weight())
+		}
+		if in.Len() != n-1 || seqs.Len(graph.BFS(tree, 0)) != n-1 {
+			t.Fatalf("trial %d: Tarjan(%v, 0) = %v, does not span the graph", trial, g, seqs.String(got))
+		}
+
+		if want := weight(graph.Edmonds(g, 0)); weight(got) != want {
+			t.Errorf("trial %d: Tarjan(%v, 0) weight = %v, Edmonds() weight = %v", trial, g, weight(got), want)
+		}
+	}
+}
+
+func TestTarjanUnreachable(t *testing.T) {
+	g := hashgraph.New[int, int](true)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 3, 2)
+	g.AddEdge(4, 5, 3)
+	g.AddEdge(5, 2, 1)
+
+	got, err := graph.Tarjan(g, 1)
+	var unreachable graph.UnreachableError[int]
+	if !errors.As(err, &unreachable) {
+		t.Fatalf("Tarjan(%v, 1) error = %v, want UnreachableError", g, err)
+	}
+	slices.Sort(unreachable.Vertices)
+	if !slices.Equal(unreachable.Vertices, []int{4, 5}) {
+		t.Errorf("Tarjan(%v, 1) unreachable = %v, want [4 5]", g, unreachable.Vertices)
+	}
+
+	if got != nil {
+		t.Errorf("Tarjan(%v, 1) = %v, want nil", g, seqs.String(got))
+	}
+}
@@ -0,0 +1,189 @@
+package graph
+
+import (
+	"iter"
+	"slices"
+	"strings"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/deque"
+	"github.com/elordeiro/goext/containers/set"
+	"github.com/elordeiro/goext/containers/tuples"
+)
+
+// NegativeCycleError is returned when a shortest path algorithm finds a cycle with
+// a negative total weight. Cycle holds the edges of the offending cycle in order,
+// so that the destination of the last edge is the source of the first.
+type NegativeCycleError[V comparable, N constraints.Number] struct {
+	Cycle []tuples.Edge[V, N]
+}
+
+func (e NegativeCycleError[V, N]) Error() string {
+	var sb strings.Builder
+	sb.WriteString("graph has a negative cycle:")
+	for _, edge := range e.Cycle {
+		sb.WriteByte(' ')
+		sb.WriteString(edge.String())
+	}
+	return sb.String()
+}
+
+// BellmanFord returns the shortest distance from the vertex start to every vertex
+// reachable from it. Unlike Dijkstra, edge weights may be negative. Vertices that
+// cannot be reached from start are not present in the returned map. If a negative
+// cycle is reachable from start, a nil map and a NegativeCycleError carrying the
+// cycle are returned. Note that an undirected edge with a negative weight is
+// itself a negative cycle.
+func BellmanFord[V comparable, N constraints.Number](g Graph[V, N], start V) (map[V]N, error) {
+	dist, _, err := bellmanFord(g, start)
+	return dist, err
+}
+
+// SPFA returns the same result as BellmanFord using the queue based Shortest Path
+// Faster Algorithm. Only the vertices whose distance changed are revisited, which
+// usually makes it much faster than BellmanFord on sparse graphs, although its worst
+// case running time is the same.
+func SPFA[V comparable, N constraints.Number](g Graph[V, N], start V) (map[V]N, error) {
+	dist, _, err := spfa(g, start)
+	return dist, err
+}
+
+// bellmanFord returns the distance and predecessor maps of the shortest paths
+// from start.
+func bellmanFord[V comparable, N constraints.Number](
+	g Graph[V, N],
+	start V,
+) (map[V]N, map[V]tuples.Pair[V, N], error) {
+	return relaxEdges(g, map[V]N{start: 0}, g.VertexCount()-1)
+}
+
+// relaxEdges repeatedly relaxes every edge of g whose source already has a distance
+// in dist, for at most rounds rounds, and returns the updated distances along with
+// the predecessor map. If the distances still change after that, the graph has a
+// negative cycle and a NegativeCycleError is returned.
+func relaxEdges[V comparable, N constraints.Number](
+	g Graph[V, N],
+	dist map[V]N,
+	rounds int,
+) (map[V]N, map[V]tuples.Pair[V, N], error) {
+	prev := map[V]tuples.Pair[V, N]{}
+
+	// relax relaxes every edge once and reports whether any distance changed
+	relax := func() bool {
+		changed := false
+		for src := range g.Vertices() {
+			d, ok := dist[src]
+			if !ok {
+				continue
+			}
+			for dst, w := range g.Neighbors(src) {
+				if old, ok := dist[dst]; !ok || d+w < old {
+					dist[dst] = d + w
+					prev[dst] = tuples.NewPair(src, w)
+					changed = true
+				}
+			}
+		}
+		return changed
+	}
+
+	for range rounds {
+		if !relax() {
+			return dist, prev, nil
+		}
+	}
+
+	// any change after that many rounds means a negative cycle can be reached
+	// and the predecessor graph now contains it
+	if relax() {
+		cycle, _ := predecessorCycle(prev, g.Vertices())
+		return nil, nil, NegativeCycleError[V, N]{Cycle: cycle}
+	}
+	return dist, prev, nil
+}
+
+// spfa returns the distance and predecessor maps of the shortest paths from start.
+func spfa[V comparable, N constraints.Number](
+	g Graph[V, N],
+	start V,
+) (map[V]N, map[V]tuples.Pair[V, N], error) {
+	dist := map[V]N{start: 0}
+	prev := map[V]tuples.Pair[V, N]{}
+	edges := map[V]int{start: 0} // number of edges on the current path to a vertex
+
+	n := g.VertexCount()
+	queued := set.New(start)
+	q := deque.New(start)
+
+	for !q.IsEmpty() {
+		src := q.PopFront()
+		queued.Remove(src)
+		for dst, w := range g.Neighbors(src) {
+			if old, ok := dist[dst]; ok && dist[src]+w >= old {
+				continue
+			}
+			dist[dst] = dist[src] + w
+			prev[dst] = tuples.NewPair(src, w)
+			edges[dst] = edges[src] + 1
+
+			// a shortest path cannot have n edges, so the predecessor graph
+			// is about to close a negative cycle
+			if edges[dst] >= n {
+				if cycle, ok := predecessorCycle(prev, slices.Values([]V{dst})); ok {
+					return nil, nil, NegativeCycleError[V, N]{Cycle: cycle}
+				}
+			}
+			if !queued.Contains(dst) {
+				queued.Add(dst)
+				q.PushBack(dst)
+			}
+		}
+	}
+
+	return dist, prev, nil
+}
+
+// predecessorCycle follows the predecessors in prev starting from each of the
+// vertices in from and returns the edges of the first cycle it runs into.
+// If there is no such cycle, it returns false.
+func predecessorCycle[V comparable, N constraints.Number](
+	prev map[V]tuples.Pair[V, N],
+	from iter.Seq[V],
+) ([]tuples.Edge[V, N], bool) {
+	done := set.New[V]()
+	for v := range from {
+		walk := set.New[V]()
+		cyclic := false
+		for !done.Contains(v) {
+			if walk.Contains(v) {
+				cyclic = true
+				break
+			}
+			walk.Add(v)
+			p, ok := prev[v]
+			if !ok {
+				break
+			}
+			v = p.Left()
+		}
+		if !cyclic {
+			for w := range walk {
+				done.Add(w)
+			}
+			continue
+		}
+
+		cycle := []tuples.Edge[V, N]{}
+		for start := v; ; {
+			p := prev[v]
+			cycle = append(cycle, tuples.NewEdge(p.Left(), v, p.Right()))
+			v = p.Left()
+			if v == start {
+				break
+			}
+		}
+		slices.Reverse(cycle)
+		return cycle, true
+	}
+	return nil, false
+}
@@ -0,0 +1,118 @@
+package graph_test
+
+import (
+	"errors"
+	"maps"
+	"testing"
+
+	"github.com/elordeiro/goext/containers/graph"
+	"github.com/elordeiro/goext/containers/hashgraph"
+)
+
+func TestBellmanFord(t *testing.T) {
+	tests := []struct {
+		name     string
+		start    int
+		edges    [][3]int
+		directed bool
+		want     map[int]int
+	}{
+		{
+			"positive weights",
+			0,
+			[][3]int{{0, 1, 4}, {0, 2, 1}, {1, 3, 1}, {2, 1, 2}, {2, 3, 5}, {3, 4, 3}},
+			false,
+			map[int]int{0: 0, 1: 3, 2: 1, 3: 4, 4: 7},
+		},
+		{
+			"negative weights",
+			0,
+			[][3]int{{0, 1, 4}, {0, 2, 5}, {1, 3, 3}, {2, 1, -3}, {3, 4, 2}, {4, 2, 1}},
+			true,
+			map[int]int{0: 0, 1: 2, 2: 5, 3: 5, 4: 7},
+		},
+		{
+			"unreachable vertices",
+			1,
+			[][3]int{{1, 2, -1}, {2, 3, -1}, {4, 1, 1}, {5, 6, 1}},
+			true,
+			map[int]int{1: 0, 2: -1, 3: -2},
+		},
+		{
+			"negative cycle not reachable",
+			1,
+			[][3]int{{1, 2, 2}, {3, 4, -1}, {4, 3, -1}},
+			true,
+			map[int]int{1: 0, 2: 2},
+		},
+	}
+
+	for _, tc := range tests {
+		g := hashgraph.New[int, int](tc.directed)
+		for _, e := range tc.edges {
+			g.AddEdge(e[0], e[1], e[2])
+		}
+
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := graph.BellmanFord(g, tc.start)
+			if err != nil || !maps.Equal(got, tc.want) {
+				t.Errorf("BellmanFord(%v, %v) = %v, %v, want %v", g, tc.start, got, err, tc.want)
+			}
+			got, err = graph.SPFA(g, tc.start)
+			if err != nil || !maps.Equal(got, tc.want) {
+				t.Errorf("SPFA(%v, %v) = %v, %v, want %v", g, tc.start, got, err, tc.want)
+			}
+		})
+	}
+}
+
+func TestBellmanFordNegativeCycle(t *testing.T) {
+	tests := []struct {
+		name     string
+		edges    [][3]int
+		directed bool
+	}{
+		{"self loop", [][3]int{{0, 1, 1}, {1, 1, -1}}, true},
+		{"triangle", [][3]int{{0, 1, 1}, {1, 2, -1}, {2, 3, -1}, {3, 1, 1}, {3, 4, 2}}, true},
+		{"undirected negative edge", [][3]int{{0, 1, 1}, {1, 2, -1}}, false},
+	}
+
+	funcs := []struct {
+		name string
+		f    func(graph.Graph[int, int], int) (map[int]int, error)
+	}{
+		{"BellmanFord", graph.BellmanFord[int, int]},
+		{"SPFA", graph.SPFA[int, int]},
+	}
+
+	for _, tc := range tests {
+		g := hashgraph.New[int, int](tc.directed)
+		for _, e := range tc.edges {
+			g.AddEdge(e[0], e[1], e[2])
+		}
+
+		for _, fn := range funcs {
+			t.Run(fn.name+"/"+tc.name, func(t *testing.T) {
+				_, err := fn.f(g, 0)
+				var cycleErr graph.NegativeCycleError[int, int]
+				if !errors.As(err, &cycleErr) {
+					t.Fatalf("%s(%v, 0) error = %v, want NegativeCycleError", fn.name, g, err)
+				}
+
+				total := 0
+				for i, e := range cycleErr.Cycle {
+					total += e.Weight()
+					if !g.HasEdge(e.Src(), e.Dst(), e.Weight()) {
+						t.Errorf("%s(%v, 0) cycle = %v, %v is not an edge", fn.name, g, cycleErr.Cycle, e)
+					}
+					if next := cycleErr.Cycle[(i+1)%len(cycleErr.Cycle)]; e.Dst() != next.Src() {
+						t.Errorf("%s(%v, 0) cycle = %v, edges are not consecutive", fn.name, g, cycleErr.Cycle)
+					}
+				}
+				if len(cycleErr.Cycle) == 0 || total >= 0 {
+					t.Errorf("%s(%v, 0) cycle = %v, want a negative cycle", fn.name, g, cycleErr.Cycle)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package graph
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/set"
+	"github.com/elordeiro/goext/containers/tuples"
+)
+
+// ArticulationPoints returns an iter.Seq[V] over the articulation points of the
+// undirected graph g, the vertices whose removal increases the number of connected
+// components. ArticulationPoints panics if the graph is directed.
+func ArticulationPoints[V comparable, N constraints.Number](g Graph[V, N]) iter.Seq[V] {
+	points, _, _ := lowLink(g)
+	return points.All()
+}
+
+// Bridges returns an iter.Seq[tuples.Edge[V, N]] over the bridges of the undirected
+// graph g, the edges whose removal increases the number of connected components.
+// Bridges panics if the graph is directed.
+func Bridges[V comparable, N constraints.Number](g Graph[V, N]) iter.Seq[tuples.Edge[V, N]] {
+	_, bridges, _ := lowLink(g)
+	return slices.Values(bridges)
+}
+
+// BiconnectedComponents returns an iter.Seq[[]tuples.Edge[V, N]] over the biconnected
+// components of the undirected graph g, the maximal sets of edges in which any two
+// edges lie on a common simple cycle. Every edge belongs to exactly one component,
+// a bridge forms a component on its own, and isolated vertices belong to none.
+// Self-loops are ignored.
+// BiconnectedComponents panics if the graph is directed.
+func BiconnectedComponents[V comparable, N constraints.Number](g Graph[V, N]) iter.Seq[[]tuples.Edge[V, N]] {
+	_, _, components := lowLink(g)
+	return slices.Values(components)
+}
+
+// lowLink runs an iterative depth-first search over the undirected graph g and
+// returns its articulation points, bridges and biconnected components.
+func lowLink[V comparable, N constraints.Number](
+	g Graph[V, N],
+) (set.Set[V], []tuples.Edge[V, N], [][]tuples.Edge[V, N]) {
+	if g.IsDirected() {
+		panic("graph is directed")
+	}
+
+	type frame struct {
+		v, parent V
+		ns        []tuples.Pair[V, N]
+		i         int
+		root      bool
+		skipped   bool // whether the edge back to the parent was skipped already
+	}
+
+	points := set.New[V]()
+	bridges := []tuples.Edge[V, N]{}
+	components := [][]tuples.Edge[V, N]{}
+
+	disc, low := map[V]int{}, map[V]int{}
+	edges := []tuples.Edge[V, N]{} // edges of the component being explored
+	frames := []frame{}
+
+	visit := func(v, parent V, root bool) {
+		disc[v], low[v] = len(disc), len(disc)
+		ns := []tuples.Pair[V, N]{}
+		for dst, w := range g.Neighbors(v) {
+			ns = append(ns, tuples.NewPair(dst, w))
+		}
+		frames = append(frames, frame{v: v, parent: parent, ns: ns, root: root})
+	}
+
+	for start := range g.Vertices() {
+		if _, ok := disc[start]; ok {
+			continue
+		}
+		visit(start, start, true)
+		children := 0
+
+		for len(frames) > 0 {
+			f := &frames[len(frames)-1]
+			if f.i < len(f.ns) {
+				dst, w := f.ns[f.i].Left(), f.ns[f.i].Right()
+				f.i++
+				if !f.root && dst == f.parent && !f.skipped {
+					f.skipped = true
+					continue
+				}
+				if d, ok := disc[dst]; !ok {
+					edges = append(edges, tuples.NewEdge(f.v, dst, w))
+					if f.root {
+						children++
+					}
+					visit(dst, f.v, false)
+				} else if d < disc[f.v] {
+					edges = append(edges, tuples.NewEdge(f.v, dst, w))
+					low[f.v] = min(low[f.v], d)
+				}
+				continue
+			}
+
+			v := f.v
+			frames = frames[:len(frames)-1]
+			if len(frames) == 0 {
+				break
+			}
+			u := frames[len(frames)-1].v
+			low[u] = min(low[u], low[v])
+			if low[v] < disc[u] {
+				continue
+			}
+
+			// u separates the subtree of v from the rest of the graph
+			if !frames[len(frames)-1].root {
+				points.Add(u)
+			}
+			i := len(edges) - 1
+			for edges[i].Src() != u || edges[i].Dst() != v {
+				i--
+			}
+			if low[v] > disc[u] {
+				bridges = append(bridges, edges[i])
+			}
+			components = append(components, slices.Clone(edges[i:]))
+			edges = edges[:i]
+		}
+
+		if children > 1 {
+			points.Add(start)
+		}
+	}
+
+	return points, bridges, components
+}
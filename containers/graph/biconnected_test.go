@@ -0,0 +1,137 @@
+package graph_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/elordeiro/goext/containers/graph"
+	"github.com/elordeiro/goext/containers/hashgraph"
+	"github.com/elordeiro/goext/containers/set"
+	"github.com/elordeiro/goext/containers/tuples"
+	"github.com/elordeiro/goext/seqs"
+)
+
+// two triangles joined at 3, with a tail 5-6-7 hanging from 5 and an isolated 8
+func biconnectedGraph() *hashgraph.HashGraph[int, int] {
+	g := hashgraph.New[int, int](false)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 1)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 5)
+	g.AddEdge(5, 3)
+	g.AddEdge(5, 6)
+	g.AddEdge(6, 7)
+	g.AddVertex(8)
+	return g
+}
+
+func TestArticulationPoints(t *testing.T) {
+	g := biconnectedGraph()
+	got := set.New[int]()
+	for v := range graph.ArticulationPoints(g) {
+		got.Add(v)
+	}
+	if want := set.New(3, 5, 6); !got.Equal(want) {
+		t.Errorf("ArticulationPoints() = %v, want %v", got, want)
+	}
+}
+
+func TestBridges(t *testing.T) {
+	g := biconnectedGraph()
+	got := set.New[tuples.Pair[int, int]]()
+	for e := range graph.Bridges(g) {
+		got.Add(tuples.NewPair(min(e.Src(), e.Dst()), max(e.Src(), e.Dst())))
+	}
+	want := set.New(tuples.NewPair(5, 6), tuples.NewPair(6, 7))
+	if !got.Equal(want) {
+		t.Errorf("Bridges() = %v, want %v", got, want)
+	}
+}
+
+func TestBiconnectedComponents(t *testing.T) {
+	g := biconnectedGraph()
+	got := []set.Set[int]{}
+	edges := 0
+	for c := range graph.BiconnectedComponents(g) {
+		vs := set.New[int]()
+		for _, e := range c {
+			vs.Add(e.Src(), e.Dst())
+		}
+		got = append(got, vs)
+		edges += len(c)
+	}
+	want := [][]int{{1, 2, 3}, {3, 4, 5}, {5, 6}, {6, 7}}
+	if !sameComponents(slices.Values(got), want) {
+		t.Errorf("BiconnectedComponents() = %v, want %v", got, want)
+	}
+	if n := seqs.Len(g.Edges()); edges != n {
+		t.Errorf("BiconnectedComponents() has %v edges, want %v", edges, n)
+	}
+}
+
+// componentCount counts the connected components of g without the vertex skip and
+// the edge {a, b}.
+func componentCount(g *hashgraph.HashGraph[int, int], skip int, a, b int) int {
+	visited := set.New(skip)
+	count := 0
+	for v := range g.Vertices() {
+		if visited.Contains(v) {
+			continue
+		}
+		count++
+		stack := []int{v}
+		visited.Add(v)
+		for len(stack) > 0 {
+			u := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for w := range g.Neighbors(u) {
+				if (u == a && w == b) || (u == b && w == a) || visited.Contains(w) {
+					continue
+				}
+				visited.Add(w)
+				stack = append(stack, w)
+			}
+		}
+	}
+	return count
+}
+
+func TestLowLinkBruteForce(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	for range 50 {
+		g := hashgraph.New[int, int](false)
+		for v := range 15 {
+			g.AddVertex(v)
+		}
+		for range 18 {
+			g.AddEdge(r.IntN(15), r.IntN(15))
+		}
+		const none = -1
+		base := componentCount(g, none, none, none)
+
+		points := set.New[int]()
+		for v := range graph.ArticulationPoints(g) {
+			points.Add(v)
+		}
+		for v := range g.Vertices() {
+			want := componentCount(g, v, none, none) > base
+			if points.Contains(v) != want {
+				t.Fatalf("ArticulationPoints() contains %v = %v, want %v in %v", v, !want, want, g)
+			}
+		}
+
+		bridges := set.New[tuples.Pair[int, int]]()
+		for e := range graph.Bridges(g) {
+			bridges.Add(tuples.NewPair(min(e.Src(), e.Dst()), max(e.Src(), e.Dst())))
+		}
+		for e := range g.Edges() {
+			a, b := min(e.Src(), e.Dst()), max(e.Src(), e.Dst())
+			want := componentCount(g, none, a, b) > base
+			if bridges.Contains(tuples.NewPair(a, b)) != want {
+				t.Fatalf("Bridges() contains %v = %v, want %v in %v", e, !want, want, g)
+			}
+		}
+	}
+}
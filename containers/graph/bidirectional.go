@@ -0,0 +1,244 @@
+package graph
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/pq"
+	"github.com/elordeiro/goext/containers/set"
+	"github.com/elordeiro/goext/containers/tuples"
+)
+
+// BidirectionalDijkstra returns the shortest path from the vertex src to the vertex dst
+// in the graph g. The algorithm returns an iter.Seq[tuples.Edge[V, N]] that represents
+// the path. If no path exists, an empty sequence is returned. Edge weights must not be
+// negative. The search runs from both ends at once and stops once they meet, which
+// usually explores far fewer vertices than Dijkstra. The backward search of directed
+// graphs follows the edges in reverse. Unless g is an InGraph, they are indexed from
+// g.Edges on every call, so use IndexInNeighbors for repeated queries.
+// The algorithm can be configured with the following options:
+//
+//   - VertexFilterOption: return true if the edge from src to dst should be considered,
+//     false otherwise. The backward search also calls it in the direction of the edge.
+//   - PreVisitOption: execute a process with src and dst prior to relaxing an edge.
+//   - DeferredOption: execute a process with a vertex once all of its edges have been
+//     relaxed.
+func BidirectionalDijkstra[V comparable, N constraints.Number](
+	g Graph[V, N],
+	src, dst V,
+	options ...option[V],
+) iter.Seq[tuples.Edge[V, N]] {
+	opts := setOptions(options...)
+	if src == dst {
+		return func(yield func(tuples.Edge[V, N]) bool) {}
+	}
+
+	type side struct {
+		pq        *pq.PQ[tuples.Pair[V, N]]
+		dist      map[V]N
+		prev      map[V]tuples.Pair[V, N]
+		settled   set.Set[V]
+		neighbors func(V) iter.Seq2[V, N]
+		forward   bool
+	}
+	newSide := func(start V, neighbors func(V) iter.Seq2[V, N], forward bool) *side {
+		return &side{
+			pq: pq.NewPQFunc(func(p1, p2 tuples.Pair[V, N]) bool {
+				return p1.Right() < p2.Right()
+			}, tuples.NewPair(start, N(0))),
+			dist:      map[V]N{start: 0},
+			prev:      map[V]tuples.Pair[V, N]{},
+			settled:   set.New[V](),
+			neighbors: neighbors,
+			forward:   forward,
+		}
+	}
+	f := newSide(src, g.Neighbors, true)
+	b := newSide(dst, inNeighbors(g), false)
+
+	var best N
+	var meet V
+	found := false
+	for !f.pq.IsEmpty() && !b.pq.IsEmpty() {
+		// no path through an unsettled vertex can be shorter than the best one
+		if found && f.pq.Top().Right()+b.pq.Top().Right() >= best {
+			break
+		}
+		s, other := f, b
+		if b.pq.Len() < f.pq.Len() {
+			s, other = b, f
+		}
+
+		u := s.pq.Pop().Left()
+		if s.settled.Contains(u) {
+			continue
+		}
+		s.settled.Add(u)
+		for v, w := range s.neighbors(u) {
+			from, to := u, v
+			if !s.forward {
+				from, to = v, u
+			}
+			if s.settled.Contains(v) || !opts.vertexFilter(from, to) {
+				continue
+			}
+			if d, ok := s.dist[v]; !ok || s.dist[u]+w < d {
+				opts.preVisit(from, to)
+				s.dist[v] = s.dist[u] + w
+				s.prev[v] = tuples.NewPair(u, w)
+				s.pq.Push(tuples.NewPair(v, s.dist[v]))
+			}
+			if d, ok := other.dist[v]; ok && (!found || s.dist[v]+d < best) {
+				best, meet, found = s.dist[v]+d, v, true
+			}
+		}
+		opts.deferred(u)
+	}
+
+	if !found {
+		return func(yield func(tuples.Edge[V, N]) bool) {}
+	}
+	return joinPaths(src, dst, meet, f.prev, b.prev)
+}
+
+// BidirectionalBFS returns the path with the fewest edges from the vertex src to the
+// vertex dst in the graph g. The algorithm returns an iter.Seq[tuples.Edge[V, N]] that
+// represents the path. If no path exists, an empty sequence is returned. The search
+// expands a level from whichever end has the smaller frontier until they meet. The
+// backward search of directed graphs follows the edges in reverse. Unless g is an
+// InGraph, they are indexed from g.Edges on every call, so use IndexInNeighbors for
+// repeated queries.
+// The algorithm can be configured with the following options:
+//
+//   - VertexFilterOption: return true if the edge from src to dst should be considered,
+//     false otherwise. The backward search also calls it in the direction of the edge.
+//   - PreVisitOption: execute a process with src and dst prior to visiting an unvisited vertex.
+//   - DeferredOption: execute a process with a vertex once all of its edges have been
+//     visited.
+func BidirectionalBFS[V comparable, N constraints.Number](
+	g Graph[V, N],
+	src, dst V,
+	options ...option[V],
+) iter.Seq[tuples.Edge[V, N]] {
+	opts := setOptions(options...)
+	if src == dst {
+		return func(yield func(tuples.Edge[V, N]) bool) {}
+	}
+
+	type side struct {
+		frontier  []V
+		depth     map[V]int
+		prev      map[V]tuples.Pair[V, N]
+		neighbors func(V) iter.Seq2[V, N]
+		forward   bool
+	}
+	f := &side{[]V{src}, map[V]int{src: 0}, map[V]tuples.Pair[V, N]{}, g.Neighbors, true}
+	b := &side{[]V{dst}, map[V]int{dst: 0}, map[V]tuples.Pair[V, N]{}, inNeighbors(g), false}
+
+	for len(f.frontier) > 0 && len(b.frontier) > 0 {
+		s, other := f, b
+		if len(b.frontier) < len(f.frontier) {
+			s, other = b, f
+		}
+
+		// the whole level is expanded, since a later vertex of the level may meet
+		// the other side closer to its end
+		var meet V
+		best, found := 0, false
+		next := []V{}
+		for _, u := range s.frontier {
+			for v, w := range s.neighbors(u) {
+				from, to := u, v
+				if !s.forward {
+					from, to = v, u
+				}
+				if _, ok := s.depth[v]; ok || !opts.vertexFilter(from, to) {
+					continue
+				}
+				opts.preVisit(from, to)
+				s.depth[v] = s.depth[u] + 1
+				s.prev[v] = tuples.NewPair(u, w)
+				next = append(next, v)
+				if d, ok := other.depth[v]; ok && (!found || s.depth[v]+d < best) {
+					best, meet, found = s.depth[v]+d, v, true
+				}
+			}
+			opts.deferred(u)
+		}
+		if found {
+			return joinPaths(src, dst, meet, f.prev, b.prev)
+		}
+		s.frontier = next
+	}
+
+	return func(yield func(tuples.Edge[V, N]) bool) {}
+}
+
+// joinPaths returns the path from src to dst through the vertex meet, where forward
+// leads back from meet to src and backward leads on from meet to dst.
+func joinPaths[V comparable, N constraints.Number](
+	src, dst, meet V,
+	forward, backward map[V]tuples.Pair[V, N],
+) iter.Seq[tuples.Edge[V, N]] {
+	path := slices.Collect(rebuildPath(meet, src, forward))
+	for v := meet; v != dst; {
+		p := backward[v]
+		path = append(path, tuples.NewEdge(v, p.Left(), p.Right()))
+		v = p.Left()
+	}
+	return slices.Values(path)
+}
+
+// InGraph is a Graph that can also list the edges into a vertex. The backward searches
+// of BidirectionalDijkstra and BidirectionalBFS follow its in-neighbors instead of
+// indexing the edges of a directed graph on every call.
+type InGraph[V comparable, N constraints.Number] interface {
+	Graph[V, N]
+	InNeighbors(V) iter.Seq2[V, N]
+}
+
+// inIndex is an InGraph that serves the in-neighbors of a graph from an index
+type inIndex[V comparable, N constraints.Number] struct {
+	Graph[V, N]
+	in map[V][]tuples.Pair[V, N]
+}
+
+// IndexInNeighbors returns an InGraph over the graph g, whose in-neighbors are indexed
+// from g.Edges once. The index is not updated when g changes, so it should be rebuilt
+// after the edges of g are modified. Undirected graphs are not indexed, since their
+// in-neighbors are their neighbors.
+func IndexInNeighbors[V comparable, N constraints.Number](g Graph[V, N]) InGraph[V, N] {
+	if ig, ok := g.(InGraph[V, N]); ok {
+		return ig
+	}
+	idx := inIndex[V, N]{Graph: g}
+	if g.IsDirected() {
+		idx.in = map[V][]tuples.Pair[V, N]{}
+		for e := range g.Edges() {
+			idx.in[e.Dst()] = append(idx.in[e.Dst()], tuples.NewPair(e.Src(), e.Weight()))
+		}
+	}
+	return idx
+}
+
+// InNeighbors returns an iter.Seq2[V, N] over the vertices with an edge into the
+// vertex v along with the weights of the edges.
+func (idx inIndex[V, N]) InNeighbors(v V) iter.Seq2[V, N] {
+	if !idx.IsDirected() {
+		return idx.Neighbors(v)
+	}
+	return func(yield func(V, N) bool) {
+		for _, p := range idx.in[v] {
+			if !yield(p.Left(), p.Right()) {
+				return
+			}
+		}
+	}
+}
+
+// inNeighbors returns a function that yields the vertices with an edge into a vertex
+// of the graph g along with the weight of the edge.
+func inNeighbors[V comparable, N constraints.Number](g Graph[V, N]) func(V) iter.Seq2[V, N] {
+	return IndexInNeighbors(g).InNeighbors
+}
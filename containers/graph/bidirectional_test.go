@@ -0,0 +1,164 @@
+package graph_test
+
+import (
+	"iter"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/elordeiro/goext/containers/graph"
+	"github.com/elordeiro/goext/containers/hashgraph"
+	"github.com/elordeiro/goext/containers/tuples"
+	"github.com/elordeiro/goext/seqs"
+)
+
+// checkPath fails the test if path does not lead from src to dst along edges of g.
+func checkPath[V comparable](t *testing.T, g *hashgraph.HashGraph[V, int], path []tuples.Edge[V, int], src, dst V) {
+	t.Helper()
+	at := src
+	for _, e := range path {
+		if e.Src() != at || !g.HasEdge(e.Src(), e.Dst(), e.Weight()) {
+			t.Fatalf("path %v from %v to %v is broken at %v", path, src, dst, e)
+		}
+		at = e.Dst()
+	}
+	if at != dst {
+		t.Fatalf("path %v ends at %v, want %v", path, at, dst)
+	}
+}
+
+func TestBidirectionalAgree(t *testing.T) {
+	r := rand.New(rand.NewPCG(5, 6))
+	for _, directed := range []bool{true, false} {
+		for range 30 {
+			g := hashgraph.New[int, int](directed)
+			for v := range 40 {
+				g.AddVertex(v)
+			}
+			for range 80 {
+				g.AddEdge(r.IntN(40), r.IntN(40), r.IntN(10)+1)
+			}
+
+			src := r.IntN(40)
+			tree := graph.DijkstraAll(g, src)
+			idx := graph.IndexInNeighbors(g)
+			for dst := range 40 {
+				if dst == src {
+					continue
+				}
+				path := slices.Collect(graph.BidirectionalDijkstra(g, src, dst))
+				hops := slices.Collect(graph.BidirectionalBFS(idx, src, dst))
+				if !tree.HasPathTo(dst) {
+					if len(path) != 0 || len(hops) != 0 {
+						t.Fatalf("found a path from %v to %v in %v, want none", src, dst, g)
+					}
+					continue
+				}
+
+				checkPath(t, g, path, src, dst)
+				cost := 0
+				for _, e := range path {
+					cost += e.Weight()
+				}
+				if cost != tree.Dist(dst) {
+					t.Fatalf("BidirectionalDijkstra(%v, %v) cost = %v, want %v", src, dst, cost, tree.Dist(dst))
+				}
+
+				checkPath(t, g, hops, src, dst)
+				want := seqs.Len(graph.ShortesPath(g, src, dst))
+				if len(hops) != want {
+					t.Fatalf("BidirectionalBFS(%v, %v) length = %v, want %v", src, dst, len(hops), want)
+				}
+			}
+		}
+	}
+}
+
+// countingGraph counts the calls to the Edges method of a graph
+type countingGraph struct {
+	*hashgraph.HashGraph[int, int]
+	calls int
+}
+
+func (g *countingGraph) Edges() iter.Seq[tuples.Edge[int, int]] {
+	g.calls++
+	return g.HashGraph.Edges()
+}
+
+func TestIndexInNeighbors(t *testing.T) {
+	g := &countingGraph{HashGraph: hashgraph.New[int, int](true)}
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 3, 1)
+	g.AddEdge(3, 4, 1)
+
+	idx := graph.IndexInNeighbors(g)
+	if got := maps.Collect(idx.InNeighbors(3)); !maps.Equal(got, map[int]int{2: 1}) {
+		t.Errorf("InNeighbors(3) = %v, want map[2:1]", got)
+	}
+	want := slices.Values(tuples.Edges([3]int{1, 2, 1}, [3]int{2, 3, 1}, [3]int{3, 4, 1}))
+	for range 3 {
+		if got := graph.BidirectionalDijkstra(idx, 1, 4); !seqs.Equal(got, want) {
+			t.Errorf("BidirectionalDijkstra() = %v, want %v", seqs.String(got), seqs.String(want))
+		}
+		if got := graph.BidirectionalBFS(idx, 1, 4); !seqs.Equal(got, want) {
+			t.Errorf("BidirectionalBFS() = %v, want %v", seqs.String(got), seqs.String(want))
+		}
+	}
+	if g.calls != 1 {
+		t.Errorf("Edges() called %v times, want the index built once", g.calls)
+	}
+}
+
+func TestBidirectionalFilter(t *testing.T) {
+	g := hashgraph.New[int, int](true)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 4, 1)
+	g.AddEdge(1, 3, 5)
+	g.AddEdge(3, 4, 5)
+	avoid := graph.VertexFilterOption(func(src, dst int) bool { return dst != 2 })
+
+	want := slices.Values(tuples.Edges([3]int{1, 3, 5}, [3]int{3, 4, 5}))
+	if got := graph.BidirectionalDijkstra(g, 1, 4, avoid); !seqs.Equal(got, want) {
+		t.Errorf("BidirectionalDijkstra() = %v, want %v", seqs.String(got), seqs.String(want))
+	}
+	if got := graph.BidirectionalBFS(g, 1, 4, avoid); !seqs.Equal(got, want) {
+		t.Errorf("BidirectionalBFS() = %v, want %v", seqs.String(got), seqs.String(want))
+	}
+}
+
+func TestKShortestPaths(t *testing.T) {
+	g := hashgraph.New[string, int](true)
+	g.AddEdge("C", "D", 3)
+	g.AddEdge("C", "E", 2)
+	g.AddEdge("D", "F", 4)
+	g.AddEdge("E", "D", 1)
+	g.AddEdge("E", "F", 2)
+	g.AddEdge("E", "G", 3)
+	g.AddEdge("F", "G", 2)
+	g.AddEdge("F", "H", 1)
+	g.AddEdge("G", "H", 2)
+
+	// the third path ties with C E F G H
+	want := []int{5, 7, 8}
+	got := []int{}
+	for path := range graph.KShortestPaths(g, "C", "H", 3) {
+		checkPath(t, g, slices.Collect(path), "C", "H")
+		cost := 0
+		for e := range path {
+			cost += e.Weight()
+		}
+		got = append(got, cost)
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("KShortestPaths() costs = %v, want %v", got, want)
+	}
+
+	// there are only so many loopless paths
+	if n := seqs.Len(graph.KShortestPaths(g, "C", "H", 100)); n != 7 {
+		t.Errorf("len(KShortestPaths(100)) = %v, want 7", n)
+	}
+	if n := seqs.Len(graph.KShortestPaths(g, "H", "C", 3)); n != 0 {
+		t.Errorf("len(KShortestPaths(H, C)) = %v, want 0", n)
+	}
+}
@@ -0,0 +1,330 @@
+package graph
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/deque"
+	"github.com/elordeiro/goext/containers/set"
+	"github.com/elordeiro/goext/containers/tuples"
+	extMath "github.com/elordeiro/goext/math"
+)
+
+// Bipartition splits the vertices of the graph g into two sets such that every edge
+// goes between the two sets. If there is no such split, two nil sets and a CycleError
+// carrying a cycle of odd length are returned. Edge directions are ignored, and the
+// edges of the cycle are oriented in the direction the cycle is walked.
+// The algorithm used is breadth-first search.
+func Bipartition[V comparable, N constraints.Number](g Graph[V, N]) (set.Set[V], set.Set[V], error) {
+	// the edges of directed graphs are also followed in reverse
+	in := map[V][]V{}
+	if g.IsDirected() {
+		for e := range g.Edges() {
+			in[e.Dst()] = append(in[e.Dst()], e.Src())
+		}
+	}
+	undirected := NewImplicitGraph(func(v V) iter.Seq2[V, N] {
+		return func(yield func(V, N) bool) {
+			for dst, w := range g.Neighbors(v) {
+				if !yield(dst, w) {
+					return
+				}
+			}
+			for _, src := range in[v] {
+				// the coloring doesn't use the weights
+				if !yield(src, 0) {
+					return
+				}
+			}
+		}
+	})
+
+	color := map[V]bool{}
+	parent := map[V]V{}
+	var conflict []V
+	colorOption := PreVisitOption(func(src, dst V) {
+		color[dst] = !color[src]
+		parent[dst] = src
+	})
+	// BFS calls it with every edge into a vertex that has been colored already
+	conflictOption := EarlyReturnOption(func(src, dst V) bool {
+		if color[src] == color[dst] {
+			conflict = []V{src, dst}
+		}
+		return conflict != nil
+	})
+	for start := range g.Vertices() {
+		if _, ok := color[start]; ok {
+			continue
+		}
+		color[start] = false
+		BFS(undirected, start, colorOption, conflictOption)
+		if conflict != nil {
+			cycle := oddCycle(g, parent, conflict[0], conflict[1])
+			return nil, nil, CycleError[V, N]{Cycle: cycle}
+		}
+	}
+
+	left, right := set.New[V](), set.New[V]()
+	for v, c := range color {
+		if c {
+			right.Add(v)
+		} else {
+			left.Add(v)
+		}
+	}
+	return left, right, nil
+}
+
+// IsBipartite returns true if the vertices of the graph g can be split into two sets
+// such that every edge goes between the two sets and false otherwise. Edge directions
+// are ignored. Use Bipartition to get the two sets or an odd cycle as a witness.
+func IsBipartite[V comparable, N constraints.Number](g Graph[V, N]) bool {
+	_, _, err := Bipartition(g)
+	return err == nil
+}
+
+// oddCycle returns the cycle of the graph g closed by the edge from src to dst in the
+// breadth-first search tree described by parent. Both endpoints of the edge are at
+// the same depth parity, so the cycle has odd length.
+func oddCycle[V comparable, N constraints.Number](g Graph[V, N], parent map[V]V, src, dst V) []tuples.Edge[V, N] {
+	// edge returns the edge from u to v with the weight of the edge between them in
+	// either direction
+	edge := func(u, v V) tuples.Edge[V, N] {
+		for d, w := range g.Neighbors(u) {
+			if d == v {
+				return tuples.NewEdge(u, v, w)
+			}
+		}
+		for d, w := range g.Neighbors(v) {
+			if d == u {
+				return tuples.NewEdge(u, v, w)
+			}
+		}
+		return tuples.NewEdge(u, v, N(0))
+	}
+
+	// the ancestors of src, including itself
+	ancestors := set.New(src)
+	for v := src; ; {
+		p, ok := parent[v]
+		if !ok {
+			break
+		}
+		v = p
+		ancestors.Add(v)
+	}
+
+	// walk up from dst until reaching a common ancestor
+	up := []tuples.Edge[V, N]{}
+	lca := dst
+	for !ancestors.Contains(lca) {
+		up = append(up, edge(lca, parent[lca]))
+		lca = parent[lca]
+	}
+
+	down := []tuples.Edge[V, N]{}
+	for v := src; v != lca; v = parent[v] {
+		down = append(down, edge(parent[v], v))
+	}
+	slices.Reverse(down)
+
+	cycle := append(down, edge(src, dst))
+	return append(cycle, up...)
+}
+
+// HopcroftKarp returns an iter.Seq[tuples.Edge[V, N]] over the edges of a maximum
+// matching of the bipartite graph g, that is, the largest set of edges such that no
+// two of them share a vertex. Edge weights are ignored. If g is not bipartite, an
+// empty sequence and the CycleError returned by Bipartition are returned.
+// The algorithm used is Hopcroft-Karp, which runs in O(E sqrt(V)) time.
+func HopcroftKarp[V comparable, N constraints.Number](g Graph[V, N]) (iter.Seq[tuples.Edge[V, N]], error) {
+	left, right, err := Bipartition(g)
+	if err != nil {
+		return func(yield func(tuples.Edge[V, N]) bool) {}, err
+	}
+
+	type arc struct {
+		r int
+		e tuples.Edge[V, N]
+	}
+
+	li, ri := map[V]int{}, map[V]int{}
+	for v := range left {
+		li[v] = len(li)
+	}
+	for v := range right {
+		ri[v] = len(ri)
+	}
+	adj := make([][]arc, len(li))
+	for e := range g.Edges() {
+		if l, ok := li[e.Src()]; ok {
+			adj[l] = append(adj[l], arc{ri[e.Dst()], e})
+		} else {
+			adj[li[e.Dst()]] = append(adj[li[e.Dst()]], arc{ri[e.Src()], e})
+		}
+	}
+
+	const free = -1
+	matchL := make([]int, len(li))
+	matchR := make([]int, len(ri))
+	matched := make([]tuples.Edge[V, N], len(li))
+	for i := range matchL {
+		matchL[i] = free
+	}
+	for i := range matchR {
+		matchR[i] = free
+	}
+
+	// bfs layers the left vertices by their distance from a free left vertex along
+	// alternating paths, and reports whether a free right vertex can be reached.
+	dist := make([]int, len(li))
+	bfs := func() bool {
+		q := deque.New[int]()
+		for l := range matchL {
+			if matchL[l] == free {
+				dist[l] = 0
+				q.PushBack(l)
+			} else {
+				dist[l] = -1
+			}
+		}
+		found := false
+		for !q.IsEmpty() {
+			l := q.PopFront()
+			for _, a := range adj[l] {
+				next := matchR[a.r]
+				if next == free {
+					found = true
+				} else if dist[next] == -1 {
+					dist[next] = dist[l] + 1
+					q.PushBack(next)
+				}
+			}
+		}
+		return found
+	}
+
+	// dfs looks for an augmenting path from the left vertex l along the layers
+	var dfs func(l int) bool
+	dfs = func(l int) bool {
+		for _, a := range adj[l] {
+			next := matchR[a.r]
+			if next == free || (dist[next] == dist[l]+1 && dfs(next)) {
+				matchL[l], matchR[a.r], matched[l] = a.r, l, a.e
+				return true
+			}
+		}
+		dist[l] = -1
+		return false
+	}
+
+	for bfs() {
+		for l := range matchL {
+			if matchL[l] == free {
+				dfs(l)
+			}
+		}
+	}
+
+	return func(yield func(tuples.Edge[V, N]) bool) {
+		for l, r := range matchL {
+			if r != free && !yield(matched[l]) {
+				return
+			}
+		}
+	}, nil
+}
+
+// Hungarian solves the assignment problem for the cost matrix costs, where costs[i][j]
+// is the cost of assigning row i to column j. Every row is assigned to a different
+// column, or every column to a different row if there are more rows than columns,
+// such that the total cost is minimal. It returns, for every row, the column it is
+// assigned to, or -1 if it is left unassigned, along with the total cost.
+// The algorithm used is the Hungarian algorithm, which runs in O(n^2 m) time.
+func Hungarian[N constraints.Number](costs [][]N) ([]int, N) {
+	n := len(costs)
+	if n == 0 {
+		return []int{}, 0
+	}
+	m := len(costs[0])
+	if n > m {
+		// solve the transposed problem and invert the assignment
+		transposed := make([][]N, m)
+		for j := range m {
+			transposed[j] = make([]N, n)
+			for i := range n {
+				transposed[j][i] = costs[i][j]
+			}
+		}
+		cols, total := Hungarian(transposed)
+		rows := make([]int, n)
+		for i := range rows {
+			rows[i] = -1
+		}
+		for j, i := range cols {
+			rows[i] = j
+		}
+		return rows, total
+	}
+
+	// potentials for rows (u) and columns (v), and the row assigned to every column
+	// in p, all 1-indexed with column 0 as a sentinel
+	inf := extMath.Inf[N]()
+	u := make([]N, n+1)
+	v := make([]N, m+1)
+	p := make([]int, m+1)
+	way := make([]int, m+1)
+
+	for i := 1; i <= n; i++ {
+		p[0] = i
+		j0 := 0
+		minv := make([]N, m+1)
+		used := make([]bool, m+1)
+		for j := range minv {
+			minv[j] = inf
+		}
+		for p[j0] != 0 {
+			used[j0] = true
+			i0, delta, j1 := p[j0], inf, 0
+			for j := 1; j <= m; j++ {
+				if used[j] {
+					continue
+				}
+				if cur := costs[i0-1][j-1] - u[i0] - v[j]; cur < minv[j] {
+					minv[j] = cur
+					way[j] = j0
+				}
+				if minv[j] < delta {
+					delta = minv[j]
+					j1 = j
+				}
+			}
+			for j := 0; j <= m; j++ {
+				if used[j] {
+					u[p[j]] += delta
+					v[j] -= delta
+				} else {
+					minv[j] -= delta
+				}
+			}
+			j0 = j1
+		}
+		for j0 != 0 {
+			j1 := way[j0]
+			p[j0] = p[j1]
+			j0 = j1
+		}
+	}
+
+	rows := make([]int, n)
+	var total N
+	for j := 1; j <= m; j++ {
+		if p[j] != 0 {
+			rows[p[j]-1] = j - 1
+			total += costs[p[j]-1][j-1]
+		}
+	}
+	return rows, total
+}
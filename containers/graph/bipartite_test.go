@@ -0,0 +1,152 @@
+package graph_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/elordeiro/goext/containers/graph"
+	"github.com/elordeiro/goext/containers/hashgraph"
+	"github.com/elordeiro/goext/containers/set"
+	"github.com/elordeiro/goext/seqs"
+)
+
+func TestBipartition(t *testing.T) {
+	tests := []struct {
+		name     string
+		edges    [][2]int
+		directed bool
+		want     bool
+	}{
+		{"even cycle", [][2]int{{1, 2}, {2, 3}, {3, 4}, {4, 1}}, false, true},
+		{"odd cycle", [][2]int{{1, 2}, {2, 3}, {3, 1}}, false, false},
+		{"tree", [][2]int{{1, 2}, {1, 3}, {3, 4}, {3, 5}}, false, true},
+		{"odd cycle with tail", [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 5}, {5, 1}, {5, 6}}, false, false},
+		{"directed odd cycle", [][2]int{{1, 2}, {3, 2}, {1, 3}}, true, false},
+		{"directed", [][2]int{{1, 2}, {3, 2}, {3, 4}}, true, true},
+		{"self loop", [][2]int{{1, 2}, {2, 2}}, false, false},
+		{"disconnected", [][2]int{{1, 2}, {3, 4}, {4, 5}, {5, 6}, {6, 3}}, false, true},
+	}
+
+	for _, tc := range tests {
+		g := hashgraph.New[int, int](tc.directed)
+		for _, e := range tc.edges {
+			g.AddEdge(e[0], e[1])
+		}
+
+		t.Run(tc.name, func(t *testing.T) {
+			if got := graph.IsBipartite(g); got != tc.want {
+				t.Errorf("IsBipartite(%v) = %v, want %v", g, got, tc.want)
+			}
+
+			left, right, err := graph.Bipartition(g)
+			if tc.want {
+				if err != nil || left.Len()+right.Len() != g.VertexCount() {
+					t.Fatalf("Bipartition(%v) = %v, %v, %v", g, left, right, err)
+				}
+				for e := range g.Edges() {
+					if left.Contains(e.Src()) == left.Contains(e.Dst()) {
+						t.Errorf("Bipartition(%v) = %v, %v, edge %v within one side", g, left, right, e)
+					}
+				}
+				return
+			}
+
+			var cycleErr graph.CycleError[int, int]
+			if !errors.As(err, &cycleErr) || len(cycleErr.Cycle)%2 != 1 {
+				t.Fatalf("Bipartition(%v) error = %v, want odd cycle", g, err)
+			}
+			for i, e := range cycleErr.Cycle {
+				if !g.HasEdge(e.Src(), e.Dst()) && !g.HasEdge(e.Dst(), e.Src()) {
+					t.Errorf("Bipartition(%v) cycle = %v, %v is not an edge", g, cycleErr.Cycle, e)
+				}
+				if next := cycleErr.Cycle[(i+1)%len(cycleErr.Cycle)]; e.Dst() != next.Src() {
+					t.Errorf("Bipartition(%v) cycle = %v, edges are not consecutive", g, cycleErr.Cycle)
+				}
+			}
+		})
+	}
+}
+
+func TestHopcroftKarp(t *testing.T) {
+	tests := []struct {
+		name     string
+		edges    [][2]int
+		directed bool
+		want     int
+	}{
+		{"perfect", [][2]int{{1, 10}, {1, 11}, {2, 10}, {3, 11}, {3, 12}}, true, 3},
+		{"augmenting path", [][2]int{{1, 10}, {2, 10}, {2, 11}, {3, 11}, {3, 12}, {4, 12}}, false, 3},
+		{"star", [][2]int{{1, 10}, {1, 11}, {1, 12}}, false, 1},
+		{"complete", [][2]int{{1, 10}, {1, 11}, {2, 10}, {2, 11}}, false, 2},
+		{"unbalanced", [][2]int{{1, 10}, {2, 10}, {3, 10}, {3, 11}, {4, 11}, {4, 12}, {5, 12}, {5, 13}}, true, 4},
+	}
+
+	for _, tc := range tests {
+		g := hashgraph.New[int, int](tc.directed)
+		for _, e := range tc.edges {
+			g.AddEdge(e[0], e[1])
+		}
+
+		t.Run(tc.name, func(t *testing.T) {
+			matching, err := graph.HopcroftKarp(g)
+			if err != nil {
+				t.Fatalf("HopcroftKarp(%v) error = %v, want nil", g, err)
+			}
+			if got := seqs.Len(matching); got != tc.want {
+				t.Errorf("HopcroftKarp(%v) = %v, want %v edges", g, seqs.String(matching), tc.want)
+			}
+			used := set.New[int]()
+			for e := range matching {
+				if !g.HasEdge(e.Src(), e.Dst()) || used.Contains(e.Src()) || used.Contains(e.Dst()) {
+					t.Errorf("HopcroftKarp(%v) = %v, is not a matching", g, seqs.String(matching))
+				}
+				used.Add(e.Src(), e.Dst())
+			}
+		})
+	}
+
+	g := hashgraph.New[int, int](false)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 1)
+	if _, err := graph.HopcroftKarp(g); err == nil {
+		t.Errorf("HopcroftKarp(%v) error = nil, want CycleError", g)
+	}
+}
+
+func TestHungarian(t *testing.T) {
+	tests := []struct {
+		name  string
+		costs [][]int
+		want  int
+	}{
+		{"square", [][]int{{9, 2, 7, 8}, {6, 4, 3, 7}, {5, 8, 1, 8}, {7, 6, 9, 4}}, 13},
+		{"more columns", [][]int{{4, 1, 3}, {2, 0, 5}}, 3},
+		{"more rows", [][]int{{4, 2}, {1, 0}, {3, 5}}, 3},
+		{"negative", [][]int{{-1, -5}, {-3, -2}}, -8},
+		{"empty", [][]int{}, 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rows, got := graph.Hungarian(tc.costs)
+			if got != tc.want {
+				t.Errorf("Hungarian(%v) = %v, %v, want %v", tc.costs, rows, got, tc.want)
+			}
+			total, cols := 0, set.New[int]()
+			for i, j := range rows {
+				if j == -1 {
+					continue
+				}
+				if cols.Contains(j) {
+					t.Errorf("Hungarian(%v) = %v, column %v assigned twice", tc.costs, rows, j)
+				}
+				cols.Add(j)
+				total += tc.costs[i][j]
+			}
+			if total != got {
+				t.Errorf("Hungarian(%v) = %v, %v, assignment costs %v", tc.costs, rows, got, total)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"slices"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/tuples"
+)
+
+// ErrBudgetExceeded is returned when a search runs out of the budget set by
+// MaxExpandedOption or MaxDepthOption before reaching its goal.
+var ErrBudgetExceeded = errors.New("search budget exceeded")
+
+// limit wraps the options so that the search stops once the context is done or once
+// it has expanded maxExpanded vertices, and does not go deeper than maxDepth. An
+// interrupted search stops as if the vertex it was about to expand was its goal and
+// records why in err.
+func (o *pathFindOptions[V]) limit() {
+	if o.ctx == nil && o.maxExpanded <= 0 && o.maxDepth <= 0 {
+		return
+	}
+
+	baseCase := o.baseCase
+	expanded := 0
+	o.baseCase = func(v V) bool {
+		if o.ctx != nil && o.ctx.Err() != nil {
+			o.err = o.ctx.Err()
+			return true
+		}
+		if o.maxExpanded > 0 && expanded >= o.maxExpanded {
+			o.err = ErrBudgetExceeded
+			return true
+		}
+		expanded++
+		return baseCase(v)
+	}
+
+	if o.maxDepth > 0 {
+		vertexFilter, preVisit := o.vertexFilter, o.preVisit
+		depth := map[V]int{}
+		o.vertexFilter = func(src, dst V) bool {
+			if depth[src] >= o.maxDepth {
+				o.pruned = true
+				return false
+			}
+			return vertexFilter(src, dst)
+		}
+		o.preVisit = func(src, dst V) {
+			depth[dst] = depth[src] + 1
+			preVisit(src, dst)
+		}
+	}
+}
+
+// DFSContext works like DFS but stops once ctx is done, and returns a PathResult
+// along with an error. The goal of the search must be given by a BaseCaseOption or
+// an EarlyReturnOption. If the search is interrupted, the result holds the path to
+// the vertex it was about to expand and ctx.Err() is returned. If the goal is not reached
+// within the budget set by MaxExpandedOption or MaxDepthOption, ErrBudgetExceeded is
+// returned.
+func DFSContext[V comparable, N constraints.Number](
+	ctx context.Context,
+	g Traversable[V, N],
+	start V,
+	options ...option[V],
+) (PathResult[V, N], error) {
+	return search(ctx, start, func(stats option[V]) iter.Seq[tuples.Edge[V, N]] {
+		return DFS(g, start, append(slices.Clip(options), stats)...)
+	})
+}
+
+// BFSContext works like BFS but stops once ctx is done, and returns a PathResult
+// along with an error. The goal of the search must be given by a BaseCaseOption or
+// an EarlyReturnOption. If the search is interrupted, the result holds the path to
+// the vertex it was about to expand and ctx.Err() is returned. If the goal is not reached
+// within the budget set by MaxExpandedOption or MaxDepthOption, ErrBudgetExceeded is
+// returned.
+func BFSContext[V comparable, N constraints.Number](
+	ctx context.Context,
+	g Traversable[V, N],
+	start V,
+	options ...option[V],
+) (PathResult[V, N], error) {
+	return search(ctx, start, func(stats option[V]) iter.Seq[tuples.Edge[V, N]] {
+		return BFS(g, start, append(slices.Clip(options), stats)...)
+	})
+}
+
+// DijkstraContext works like Dijkstra but stops once ctx is done, and returns a
+// PathResult along with an error. The goal of the search must be given by a
+// BaseCaseOption or an EarlyReturnOption. If the search is interrupted, the result
+// holds the path to the vertex it was about to settle and ctx.Err() is returned. If the goal
+// is not reached within the budget set by MaxExpandedOption or MaxDepthOption,
+// ErrBudgetExceeded is returned.
+func DijkstraContext[V comparable, N constraints.Number](
+	ctx context.Context,
+	g Traversable[V, N],
+	start V,
+	options ...option[V],
+) (PathResult[V, N], error) {
+	return search(ctx, start, func(stats option[V]) iter.Seq[tuples.Edge[V, N]] {
+		return Dijkstra(g, start, append(slices.Clip(options), stats)...)
+	})
+}
+
+// AStarContext works like AStar but stops once ctx is done, and returns a PathResult
+// along with an error. The goal of the search must be given by a BaseCaseOption or an
+// EarlyReturnOption. If the search is interrupted, the result holds the path to the
+// vertex it was about to expand, the one with the lowest estimated total cost, and
+// ctx.Err() is returned. If the goal is not reached within the budget set by
+// MaxExpandedOption or MaxDepthOption, ErrBudgetExceeded is returned.
+func AStarContext[V comparable, N constraints.Number](
+	ctx context.Context,
+	g Traversable[V, N],
+	start V,
+	h heuristic[V, N],
+	options ...option[V],
+) (PathResult[V, N], error) {
+	return search(ctx, start, func(stats option[V]) iter.Seq[tuples.Edge[V, N]] {
+		return AStar(g, start, h, append(slices.Clip(options), stats)...)
+	})
+}
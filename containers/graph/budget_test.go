@@ -0,0 +1,122 @@
+package graph_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/elordeiro/goext/containers/graph"
+	"github.com/elordeiro/goext/containers/hashgraph"
+)
+
+// chainGraph returns the directed chain 0 -> 1 -> ... -> n-1.
+func chainGraph(n int) *hashgraph.HashGraph[int, int] {
+	g := hashgraph.New[int, int](true)
+	for v := range n - 1 {
+		g.AddEdge(v, v+1)
+	}
+	return g
+}
+
+func TestMaxExpanded(t *testing.T) {
+	g := chainGraph(100)
+	goal := graph.BaseCaseOption(func(v int) bool { return v == 99 })
+	budget := graph.MaxExpandedOption[int](10)
+	zero := func(int) int { return 0 }
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		search func() (graph.PathResult[int, int], error)
+	}{
+		{"DFSContext", func() (graph.PathResult[int, int], error) {
+			return graph.DFSContext(ctx, g, 0, goal, budget)
+		}},
+		{"BFSContext", func() (graph.PathResult[int, int], error) {
+			return graph.BFSContext(ctx, g, 0, goal, budget)
+		}},
+		{"DijkstraContext", func() (graph.PathResult[int, int], error) {
+			return graph.DijkstraContext(ctx, g, 0, goal, budget)
+		}},
+		{"AStarContext", func() (graph.PathResult[int, int], error) {
+			return graph.AStarContext(ctx, g, 0, zero, goal, budget)
+		}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := tc.search()
+			if !errors.Is(err, graph.ErrBudgetExceeded) {
+				t.Fatalf("%s() error = %v, want %v", tc.name, err, graph.ErrBudgetExceeded)
+			}
+			if p.Found || p.Explored != 10 {
+				t.Errorf("%s() = %+v, want 10 explored vertices and no path", tc.name, p)
+			}
+			// the partial path leads to the next vertex in line
+			want := make([]int, 11)
+			for i := range want {
+				want[i] = i
+			}
+			if got := slices.Collect(p.Vertices()); !slices.Equal(got, want) {
+				t.Errorf("%s().Vertices() = %v, want %v", tc.name, got, want)
+			}
+		})
+	}
+}
+
+func TestMaxDepth(t *testing.T) {
+	g := chainGraph(100)
+	near := graph.BaseCaseOption(func(v int) bool { return v == 5 })
+	far := graph.BaseCaseOption(func(v int) bool { return v == 6 })
+	depth := graph.MaxDepthOption[int](5)
+	ctx := context.Background()
+
+	p, err := graph.BFSContext(ctx, g, 0, near, depth)
+	if err != nil || !p.Found || p.Cost != 5 {
+		t.Errorf("BFSContext(near) = %+v, %v, want a path of cost 5", p, err)
+	}
+	p, err = graph.DijkstraContext(ctx, g, 0, far, depth)
+	if !errors.Is(err, graph.ErrBudgetExceeded) || p.Found {
+		t.Errorf("DijkstraContext(far) = %+v, %v, want %v", p, err, graph.ErrBudgetExceeded)
+	}
+
+	// a goal that cannot be reached is not a budget error when nothing was pruned
+	p, err = graph.DFSContext(ctx, g, 95, near, depth)
+	if err != nil || p.Found {
+		t.Errorf("DFSContext(unreachable) = %+v, %v, want no path and no error", p, err)
+	}
+}
+
+func TestSearchCanceled(t *testing.T) {
+	g := chainGraph(100)
+	goal := graph.BaseCaseOption(func(v int) bool { return v == 99 })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	p, err := graph.DijkstraContext(ctx, g, 0, goal)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("DijkstraContext() error = %v, want %v", err, context.Canceled)
+	}
+	if p.Found || !slices.Equal(slices.Collect(p.Vertices()), []int{0}) {
+		t.Errorf("DijkstraContext() = %+v, want the empty partial path at 0", p)
+	}
+
+	// cancel halfway through the search
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	stop := graph.PreVisitOption(func(src, dst int) {
+		if dst == 42 {
+			cancel()
+		}
+	})
+	p, err = graph.BFSContext(ctx, g, 0, goal, stop)
+	if !errors.Is(err, context.Canceled) || p.Found || p.Len() != 42 {
+		t.Errorf("BFSContext() = %+v, %v, want a partial path of 42 edges", p, err)
+	}
+
+	p, err = graph.BFSContext(context.Background(), g, 0, goal)
+	if err != nil || !p.Found || p.Len() != 99 {
+		t.Errorf("BFSContext() = %+v, %v, want a path of 99 edges", p, err)
+	}
+}
@@ -0,0 +1,229 @@
+package graph
+
+import (
+	"math"
+	"runtime"
+	"slices"
+	"sync"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/pq"
+	"github.com/elordeiro/goext/containers/tuples"
+)
+
+// PageRank returns the PageRank of every vertex of the graph g, which sum up to 1.
+// At every step a random surfer follows an edge out of the current vertex with
+// probability damping, or jumps to a random vertex otherwise. Vertices without
+// outgoing edges jump to a random vertex. Edge weights are ignored, and undirected
+// edges can be followed both ways. The ranks are updated until the sum of their
+// changes drops below tolerance or until maxIterations iterations have run.
+// The algorithm used is power iteration.
+func PageRank[V comparable, N constraints.Number](
+	g Graph[V, N],
+	damping, tolerance float64,
+	maxIterations int,
+) map[V]float64 {
+	vertices := slices.Collect(g.Vertices())
+	n := len(vertices)
+	if n == 0 {
+		return map[V]float64{}
+	}
+	index := make(map[V]int, n)
+	for i, v := range vertices {
+		index[v] = i
+	}
+	out := make([][]int, n)
+	for i, v := range vertices {
+		for dst := range g.Neighbors(v) {
+			out[i] = append(out[i], index[dst])
+		}
+	}
+
+	rank := make([]float64, n)
+	for i := range rank {
+		rank[i] = 1 / float64(n)
+	}
+	next := make([]float64, n)
+	for range maxIterations {
+		// the rank of vertices without outgoing edges is spread over every vertex
+		dangling := 0.0
+		for i := range rank {
+			if len(out[i]) == 0 {
+				dangling += rank[i]
+			}
+		}
+		base := (1-damping)/float64(n) + damping*dangling/float64(n)
+		for i := range next {
+			next[i] = base
+		}
+		for i, ns := range out {
+			share := damping * rank[i] / float64(len(ns))
+			for _, j := range ns {
+				next[j] += share
+			}
+		}
+
+		delta := 0.0
+		for i := range rank {
+			delta += math.Abs(next[i] - rank[i])
+		}
+		rank, next = next, rank
+		if delta < tolerance {
+			break
+		}
+	}
+
+	ranks := make(map[V]float64, n)
+	for i, v := range vertices {
+		ranks[v] = rank[i]
+	}
+	return ranks
+}
+
+// BetweennessCentrality returns the betweenness centrality of every vertex of the
+// graph g, that is, the number of shortest paths between two other vertices that go
+// through the vertex, where the shortest paths between every pair are weighted so
+// that they add up to 1. In undirected graphs every pair of vertices is counted once.
+// Edge weights must not be negative. The shortest paths from every source vertex are
+// computed in parallel by the given number of workers, which defaults to
+// runtime.GOMAXPROCS(0).
+// The algorithm used is Brandes' algorithm, which runs in O(VE + V^2 log V) time.
+func BetweennessCentrality[V comparable, N constraints.Number](g Graph[V, N], workers ...int) map[V]float64 {
+	nWorkers := runtime.GOMAXPROCS(0)
+	if len(workers) > 0 && workers[0] > 0 {
+		nWorkers = workers[0]
+	}
+
+	vertices := slices.Collect(g.Vertices())
+	results := make([]map[V]float64, nWorkers)
+	chunk := (len(vertices) + nWorkers - 1) / nWorkers
+	var wg sync.WaitGroup
+	for w := range nWorkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			centrality := map[V]float64{}
+			for i := w * chunk; i < min((w+1)*chunk, len(vertices)); i++ {
+				brandes(g, vertices[i], centrality)
+			}
+			results[w] = centrality
+		}()
+	}
+	wg.Wait()
+
+	centrality := make(map[V]float64, len(vertices))
+	for _, v := range vertices {
+		centrality[v] = 0
+	}
+	for _, result := range results {
+		for v, c := range result {
+			centrality[v] += c
+		}
+	}
+	if !g.IsDirected() {
+		// every path was found from both of its ends
+		for v := range centrality {
+			centrality[v] /= 2
+		}
+	}
+	return centrality
+}
+
+// brandes adds to centrality the dependency of the vertex s on every other vertex.
+func brandes[V comparable, N constraints.Number](g Graph[V, N], s V, centrality map[V]float64) {
+	pq := pq.NewPQFunc(func(p1, p2 tuples.Pair[V, N]) bool {
+		return p1.Right() < p2.Right()
+	}, tuples.NewPair(s, N(0)))
+
+	dist := map[V]N{s: 0}
+	sigma := map[V]float64{s: 1} // number of shortest paths from s
+	preds := map[V][]V{}
+	order := []V{} // vertices in the order they are settled
+	settled := map[V]bool{}
+
+	for !pq.IsEmpty() {
+		p := pq.Pop()
+		src := p.Left()
+		if settled[src] {
+			continue
+		}
+		settled[src] = true
+		order = append(order, src)
+		for dst, w := range g.Neighbors(src) {
+			if settled[dst] {
+				continue
+			}
+			d, ok := dist[dst]
+			switch {
+			case !ok || dist[src]+w < d:
+				dist[dst] = dist[src] + w
+				sigma[dst] = sigma[src]
+				preds[dst] = []V{src}
+				pq.Push(tuples.NewPair(dst, dist[dst]))
+			case dist[src]+w == d:
+				sigma[dst] += sigma[src]
+				preds[dst] = append(preds[dst], src)
+			}
+		}
+	}
+
+	delta := map[V]float64{}
+	for _, w := range slices.Backward(order) {
+		for _, v := range preds[w] {
+			delta[v] += sigma[v] / sigma[w] * (1 + delta[w])
+		}
+		if w != s {
+			centrality[w] += delta[w]
+		}
+	}
+}
+
+// ClosenessCentrality returns the closeness centrality of every vertex of the graph
+// g, that is, the inverse of the average shortest path distance from the vertex to the
+// vertices it can reach. Vertices that reach only part of the graph are scaled down by
+// the fraction of the other vertices they reach, and vertices that reach no other
+// vertex have a centrality of 0. Edge weights must not be negative.
+// The algorithm used is Dijkstra from every vertex.
+func ClosenessCentrality[V comparable, N constraints.Number](g Graph[V, N]) map[V]float64 {
+	n := g.VertexCount()
+	centrality := make(map[V]float64, n)
+	for v := range g.Vertices() {
+		dist, _, _, _ := dijkstraAll(g, v, setOptions[V]())
+		total := 0.0
+		for _, d := range dist {
+			total += float64(d)
+		}
+		reached := float64(len(dist) - 1)
+		if total > 0 {
+			centrality[v] = reached / total * reached / float64(n-1)
+		} else {
+			centrality[v] = 0
+		}
+	}
+	return centrality
+}
+
+// DegreeCentrality returns the degree centrality of every vertex of the graph g, that
+// is, the fraction of the other vertices it is connected to. In directed graphs both
+// the incoming and the outgoing edges of a vertex are counted, so the centrality can
+// exceed 1.
+func DegreeCentrality[V comparable, N constraints.Number](g Graph[V, N]) map[V]float64 {
+	degree := map[V]int{}
+	for v := range g.Vertices() {
+		degree[v] = 0
+	}
+	for e := range g.Edges() {
+		degree[e.Src()]++
+		degree[e.Dst()]++
+	}
+
+	centrality := make(map[V]float64, len(degree))
+	for v, d := range degree {
+		if len(degree) > 1 {
+			centrality[v] = float64(d) / float64(len(degree)-1)
+		} else {
+			centrality[v] = 0
+		}
+	}
+	return centrality
+}
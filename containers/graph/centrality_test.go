@@ -0,0 +1,114 @@
+package graph_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/elordeiro/goext/containers/graph"
+	"github.com/elordeiro/goext/containers/hashgraph"
+)
+
+func closeTo(got, want map[int]float64) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for v, w := range want {
+		if math.Abs(got[v]-w) > 1e-6 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestPageRank(t *testing.T) {
+	// every vertex of a cycle has the same rank
+	g := hashgraph.New[int, int](true)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 1)
+	got := graph.PageRank(g, 0.85, 1e-9, 100)
+	want := map[int]float64{1: 1.0 / 3, 2: 1.0 / 3, 3: 1.0 / 3}
+	if !closeTo(got, want) {
+		t.Errorf("PageRank() = %v, want %v", got, want)
+	}
+
+	// everyone links to 1, which links nowhere
+	g = hashgraph.New[int, int](true)
+	g.AddEdge(2, 1)
+	g.AddEdge(3, 1)
+	g.AddEdge(4, 1)
+	got = graph.PageRank(g, 0.85, 1e-12, 1000)
+	sum := 0.0
+	for v, r := range got {
+		sum += r
+		if v != 1 && r >= got[1] {
+			t.Errorf("PageRank()[%v] = %v, want less than %v", v, r, got[1])
+		}
+	}
+	if math.Abs(sum-1) > 1e-9 {
+		t.Errorf("sum(PageRank()) = %v, want 1", sum)
+	}
+}
+
+func TestBetweennessCentrality(t *testing.T) {
+	path := hashgraph.New[int, int](false)
+	path.AddEdge(1, 2)
+	path.AddEdge(2, 3)
+	path.AddEdge(3, 4)
+
+	// two shortest paths from 1 to 4, one through each of 2 and 3
+	square := hashgraph.New[int, int](false)
+	square.AddEdge(1, 2)
+	square.AddEdge(2, 4)
+	square.AddEdge(1, 3)
+	square.AddEdge(3, 4)
+
+	// the direct edge from 1 to 3 is heavier than the detour through 2
+	directed := hashgraph.New[int, int](true)
+	directed.AddEdge(1, 2, 1)
+	directed.AddEdge(2, 3, 1)
+	directed.AddEdge(1, 3, 5)
+
+	tests := []struct {
+		name string
+		g    *hashgraph.HashGraph[int, int]
+		want map[int]float64
+	}{
+		{"path", path, map[int]float64{1: 0, 2: 2, 3: 2, 4: 0}},
+		{"square", square, map[int]float64{1: 0.5, 2: 0.5, 3: 0.5, 4: 0.5}},
+		{"directed", directed, map[int]float64{1: 0, 2: 1, 3: 0}},
+	}
+	for _, tc := range tests {
+		for _, workers := range []int{1, 3} {
+			if got := graph.BetweennessCentrality(tc.g, workers); !closeTo(got, tc.want) {
+				t.Errorf("%s: BetweennessCentrality(g, %d) = %v, want %v", tc.name, workers, got, tc.want)
+			}
+		}
+	}
+}
+
+func TestClosenessCentrality(t *testing.T) {
+	g := hashgraph.New[int, int](false)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddVertex(4)
+	got := graph.ClosenessCentrality(g)
+	// 1 reaches two of the three other vertices at an average distance of 1.5
+	want := map[int]float64{1: 2.0 / 3 * 2 / 3, 2: 1 * 2.0 / 3, 3: 2.0 / 3 * 2 / 3, 4: 0}
+	if !closeTo(got, want) {
+		t.Errorf("ClosenessCentrality() = %v, want %v", got, want)
+	}
+}
+
+func TestDegreeCentrality(t *testing.T) {
+	g := hashgraph.New[int, int](false)
+	g.AddEdge(1, 2)
+	g.AddEdge(1, 3)
+	g.AddEdge(1, 4)
+	g.AddEdge(1, 5)
+	got := graph.DegreeCentrality(g)
+	want := map[int]float64{1: 1, 2: 0.25, 3: 0.25, 4: 0.25, 5: 0.25}
+	if !closeTo(got, want) {
+		t.Errorf("DegreeCentrality() = %v, want %v", got, want)
+	}
+}
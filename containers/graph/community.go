@@ -0,0 +1,343 @@
+package graph
+
+import (
+	"iter"
+	"math/rand/v2"
+	"slices"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/set"
+)
+
+// Louvain splits the vertices of the undirected graph g into communities, where the
+// edges inside every community are denser than expected if the edges were placed at
+// random. The edge weights are the strength of the connections. It returns an
+// iter.Seq[set.Set[V]] over the communities along with the modularity of the
+// partition. Louvain panics if the graph is directed.
+// The algorithm used is the Louvain method, which greedily moves every vertex to the
+// community of the neighbor that increases the modularity the most, and then merges
+// every community into a single vertex, until the modularity stops increasing.
+func Louvain[V comparable, N constraints.Number](g Graph[V, N]) (iter.Seq[set.Set[V]], float64) {
+	w := newWeighted(g)
+	n := len(w.vertices)
+
+	// community of every vertex of g, updated after every aggregation
+	member := make([]int, n)
+	for i := range member {
+		member[i] = i
+	}
+	adj := w.adj
+	for {
+		comm, moved := louvainMove(adj, w.total)
+		if !moved {
+			break
+		}
+		for i := range member {
+			member[i] = comm[member[i]]
+		}
+
+		// merge every community into a single vertex
+		next := make([]map[int]float64, slices.Max(comm)+1)
+		for c := range next {
+			next[c] = map[int]float64{}
+		}
+		for i, ns := range adj {
+			for j, a := range ns {
+				next[comm[i]][comm[j]] += a
+			}
+		}
+		adj = next
+	}
+
+	communities := w.communities(member)
+	return slices.Values(communities), w.modularity(member)
+}
+
+// louvainMove moves every vertex of the graph described by adj to the community of
+// the neighbor that increases the modularity the most, until no vertex moves. It
+// returns the community of every vertex, numbered from 0, and whether any moved.
+func louvainMove(adj []map[int]float64, total float64) ([]int, bool) {
+	n := len(adj)
+	comm := make([]int, n)
+	degree := make([]float64, n)
+	tot := make([]float64, n) // sum of the degrees of the vertices of every community
+	for i, ns := range adj {
+		comm[i] = i
+		for _, a := range ns {
+			degree[i] += a
+		}
+		tot[i] = degree[i]
+	}
+
+	moved := false
+	for changed := true; changed; {
+		changed = false
+		for i, ns := range adj {
+			// weight of the edges from i into every neighboring community
+			links := map[int]float64{}
+			for j, a := range ns {
+				if j != i {
+					links[comm[j]] += a
+				}
+			}
+
+			ci := comm[i]
+			tot[ci] -= degree[i]
+			best, bestGain := ci, links[ci]-tot[ci]*degree[i]/total
+			for c, l := range links {
+				if gain := l - tot[c]*degree[i]/total; gain > bestGain+1e-12 {
+					best, bestGain = c, gain
+				}
+			}
+			tot[best] += degree[i]
+			if best != ci {
+				comm[i] = best
+				changed, moved = true, true
+			}
+		}
+	}
+
+	// renumber the communities from 0
+	ids := map[int]int{}
+	for i, c := range comm {
+		if _, ok := ids[c]; !ok {
+			ids[c] = len(ids)
+		}
+		comm[i] = ids[c]
+	}
+	return comm, moved
+}
+
+// LabelPropagation splits the vertices of the undirected graph g into communities.
+// Every vertex starts with a label of its own, and in random order takes the label
+// carried by the largest weight of its neighbors, until every vertex carries such a
+// label. It returns an iter.Seq[set.Set[V]] over the communities along with the
+// modularity of the partition. The result depends on the order the vertices are
+// visited in, so runs can be compared by their modularity.
+// LabelPropagation panics if the graph is directed.
+// The algorithm used is asynchronous label propagation, which runs in near linear time.
+func LabelPropagation[V comparable, N constraints.Number](g Graph[V, N]) (iter.Seq[set.Set[V]], float64) {
+	w := newWeighted(g)
+	n := len(w.vertices)
+	label := make([]int, n)
+	order := make([]int, n)
+	for i := range label {
+		label[i], order[i] = i, i
+	}
+
+	// label propagation can oscillate between equally good labels, so the number of
+	// rounds is capped
+	const maxRounds = 100
+	for changed, round := true, 0; changed && round < maxRounds; round++ {
+		changed = false
+		rand.Shuffle(n, func(i, j int) { order[i], order[j] = order[j], order[i] })
+		for _, i := range order {
+			weight := map[int]float64{}
+			for j, a := range w.adj[i] {
+				if j != i {
+					weight[label[j]] += a
+				}
+			}
+			if len(weight) == 0 {
+				continue
+			}
+
+			// keep the current label on ties, or take the smallest of the best ones
+			most := 0.0
+			for _, a := range weight {
+				most = max(most, a)
+			}
+			if weight[label[i]] == most {
+				continue
+			}
+			best := n
+			for l, a := range weight {
+				if a == most && l < best {
+					best = l
+				}
+			}
+			if best != label[i] {
+				label[i] = best
+				changed = true
+			}
+		}
+	}
+
+	communities := w.communities(label)
+	return slices.Values(communities), w.modularity(label)
+}
+
+// Modularity returns the modularity of the partition of the vertices of the undirected
+// graph g into communities, that is, the fraction of the edge weight that falls
+// inside the communities minus the fraction expected if the edges were placed at
+// random. It ranges from -0.5 to 1, and higher values mean denser communities.
+// Vertices that belong to no community are placed in communities of their own.
+// Modularity panics if the graph is directed.
+func Modularity[V comparable, N constraints.Number](g Graph[V, N], communities iter.Seq[set.Set[V]]) float64 {
+	w := newWeighted(g)
+	member := make([]int, len(w.vertices))
+	for i := range member {
+		member[i] = -1
+	}
+	c := 0
+	for community := range communities {
+		for v := range community {
+			if i, ok := w.index[v]; ok {
+				member[i] = c
+			}
+		}
+		c++
+	}
+	for i := range member {
+		if member[i] == -1 {
+			member[i] = c
+			c++
+		}
+	}
+	return w.modularity(member)
+}
+
+// weighted is an undirected graph over the indexes of the vertices of a Graph, where
+// the weight of a self-loop is counted twice so that the degree of a vertex is the
+// sum of the weights in its row.
+type weighted[V comparable] struct {
+	index    map[V]int
+	vertices []V
+	adj      []map[int]float64
+	total    float64 // sum of the degrees of every vertex
+}
+
+// newWeighted returns the weighted graph of the undirected graph g. It panics if g is
+// directed.
+func newWeighted[V comparable, N constraints.Number](g Graph[V, N]) *weighted[V] {
+	if g.IsDirected() {
+		panic("graph is directed")
+	}
+	w := &weighted[V]{index: map[V]int{}}
+	for v := range g.Vertices() {
+		w.index[v] = len(w.vertices)
+		w.vertices = append(w.vertices, v)
+		w.adj = append(w.adj, map[int]float64{})
+	}
+	for i, v := range w.vertices {
+		for dst, weight := range g.Neighbors(v) {
+			a := float64(weight)
+			if dst == v {
+				a *= 2
+			}
+			w.adj[i][w.index[dst]] += a
+			w.total += a
+		}
+	}
+	return w
+}
+
+// modularity returns the modularity of the partition that places every vertex i in
+// the community member[i].
+func (w *weighted[V]) modularity(member []int) float64 {
+	if w.total == 0 {
+		return 0
+	}
+	inside := map[int]float64{}
+	tot := map[int]float64{}
+	for i, ns := range w.adj {
+		for j, a := range ns {
+			if member[i] == member[j] {
+				inside[member[i]] += a
+			}
+			tot[member[i]] += a
+		}
+	}
+	q := 0.0
+	for c, t := range tot {
+		q += inside[c]/w.total - (t/w.total)*(t/w.total)
+	}
+	return q
+}
+
+// communities returns the partition that places every vertex i in the community
+// member[i].
+func (w *weighted[V]) communities(member []int) []set.Set[V] {
+	ids := map[int]int{}
+	communities := []set.Set[V]{}
+	for i, c := range member {
+		id, ok := ids[c]
+		if !ok {
+			id = len(communities)
+			ids[c] = id
+			communities = append(communities, set.New[V]())
+		}
+		communities[id].Add(w.vertices[i])
+	}
+	return communities
+}
+
+// LocalClusteringCoefficient returns the local clustering coefficient of every vertex
+// of the undirected graph g, that is, the fraction of the pairs of its neighbors that
+// are connected by an edge. Vertices with fewer than two neighbors have a coefficient
+// of 0. Edge weights and self-loops are ignored.
+// LocalClusteringCoefficient panics if the graph is directed.
+func LocalClusteringCoefficient[V comparable, N constraints.Number](g Graph[V, N]) map[V]float64 {
+	triangles, pairs := clustering(g)
+	coefficients := make(map[V]float64, len(pairs))
+	for v, p := range pairs {
+		if p > 0 {
+			coefficients[v] = float64(triangles[v]) / float64(p)
+		} else {
+			coefficients[v] = 0
+		}
+	}
+	return coefficients
+}
+
+// GlobalClusteringCoefficient returns the global clustering coefficient, or
+// transitivity, of the undirected graph g, that is, the fraction of the paths of
+// length two whose ends are connected by an edge. A graph without such paths has a
+// coefficient of 0. Edge weights and self-loops are ignored.
+// GlobalClusteringCoefficient panics if the graph is directed.
+func GlobalClusteringCoefficient[V comparable, N constraints.Number](g Graph[V, N]) float64 {
+	triangles, pairs := clustering(g)
+	closed, total := 0, 0
+	for v, p := range pairs {
+		closed += triangles[v]
+		total += p
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(closed) / float64(total)
+}
+
+// clustering returns, for every vertex of the undirected graph g, the number of
+// triangles it belongs to and the number of pairs of its neighbors.
+func clustering[V comparable, N constraints.Number](g Graph[V, N]) (map[V]int, map[V]int) {
+	if g.IsDirected() {
+		panic("graph is directed")
+	}
+	neighbors := map[V]set.Set[V]{}
+	for v := range g.Vertices() {
+		ns := set.New[V]()
+		for dst := range g.Neighbors(v) {
+			if dst != v {
+				ns.Add(dst)
+			}
+		}
+		neighbors[v] = ns
+	}
+
+	triangles, pairs := map[V]int{}, map[V]int{}
+	for v, ns := range neighbors {
+		k := ns.Len()
+		pairs[v] = k * (k - 1) / 2
+		for u := range ns {
+			for w := range neighbors[u] {
+				if ns.Contains(w) {
+					triangles[v]++
+				}
+			}
+		}
+		// every triangle was counted from both of the other vertices
+		triangles[v] /= 2
+	}
+	return triangles, pairs
+}
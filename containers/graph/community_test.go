@@ -0,0 +1,105 @@
+package graph_test
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/elordeiro/goext/containers/graph"
+	"github.com/elordeiro/goext/containers/hashgraph"
+	"github.com/elordeiro/goext/containers/set"
+	"github.com/elordeiro/goext/containers/unionfind"
+)
+
+// two triangles joined by the edge 3-4
+func trianglesGraph() *hashgraph.HashGraph[int, int] {
+	g := hashgraph.New[int, int](false)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 1)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 5)
+	g.AddEdge(5, 6)
+	g.AddEdge(6, 4)
+	return g
+}
+
+func TestLouvain(t *testing.T) {
+	g := trianglesGraph()
+	communities, q := graph.Louvain(g)
+	if want := [][]int{{1, 2, 3}, {4, 5, 6}}; !sameComponents(communities, want) {
+		t.Errorf("Louvain() = %v, want %v", slices.Collect(communities), want)
+	}
+	// every triangle holds 6 of the 14 weight units and has a degree of 7
+	if want := 2 * (6.0/14 - 0.25); math.Abs(q-want) > 1e-9 {
+		t.Errorf("Louvain() modularity = %v, want %v", q, want)
+	}
+
+	// the partition can be loaded into a union find
+	uf := unionfind.New[int]()
+	for v := range g.Vertices() {
+		uf.MakeSet(v)
+	}
+	for c := range communities {
+		first := 0
+		for v := range c {
+			if first == 0 {
+				first = v
+			}
+			uf.Union(first, v)
+		}
+	}
+	if !uf.Connected(1, 3) || uf.Connected(3, 4) {
+		t.Errorf("Louvain() communities do not match the union find")
+	}
+}
+
+func TestLabelPropagation(t *testing.T) {
+	g := trianglesGraph()
+	communities, q := graph.LabelPropagation(g)
+	seen := set.New[int]()
+	for c := range communities {
+		for v := range c {
+			if seen.Contains(v) {
+				t.Fatalf("LabelPropagation() places %v in two communities", v)
+			}
+			seen.Add(v)
+		}
+	}
+	if seen.Len() != g.VertexCount() {
+		t.Errorf("LabelPropagation() covers %v vertices, want %v", seen.Len(), g.VertexCount())
+	}
+	if want := graph.Modularity(g, communities); math.Abs(q-want) > 1e-9 {
+		t.Errorf("LabelPropagation() modularity = %v, want %v", q, want)
+	}
+}
+
+func TestModularity(t *testing.T) {
+	g := trianglesGraph()
+	whole := slices.Values([]set.Set[int]{set.New(1, 2, 3, 4, 5, 6)})
+	if q := graph.Modularity(g, whole); math.Abs(q) > 1e-9 {
+		t.Errorf("Modularity(single community) = %v, want 0", q)
+	}
+	split := slices.Values([]set.Set[int]{set.New(1, 2, 3), set.New(4, 5, 6)})
+	if q := graph.Modularity(g, split); math.Abs(q-2*(6.0/14-0.25)) > 1e-9 {
+		t.Errorf("Modularity(triangles) = %v, want %v", q, 2*(6.0/14-0.25))
+	}
+}
+
+func TestClusteringCoefficient(t *testing.T) {
+	g := hashgraph.New[int, int](false)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 1)
+	g.AddEdge(3, 4)
+
+	got := graph.LocalClusteringCoefficient(g)
+	want := map[int]float64{1: 1, 2: 1, 3: 1.0 / 3, 4: 0}
+	if !closeTo(got, want) {
+		t.Errorf("LocalClusteringCoefficient() = %v, want %v", got, want)
+	}
+	// three closed paths out of five paths of length two
+	if got := graph.GlobalClusteringCoefficient(g); math.Abs(got-0.6) > 1e-9 {
+		t.Errorf("GlobalClusteringCoefficient() = %v, want 0.6", got)
+	}
+}
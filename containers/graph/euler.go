@@ -0,0 +1,146 @@
+package graph
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/tuples"
+	"github.com/elordeiro/goext/containers/unionfind"
+)
+
+// HasEulerianPath returns true if the graph g has a trail that uses every edge exactly
+// once and false otherwise. In directed graphs the trail follows the edge directions.
+// Vertices without edges are ignored, and a graph without edges has an empty trail.
+func HasEulerianPath[V comparable, N constraints.Number](g Graph[V, N]) bool {
+	_, ok := eulerianStart(g, false)
+	return ok
+}
+
+// HasEulerianCircuit returns true if the graph g has a closed trail that uses every
+// edge exactly once and false otherwise. In directed graphs the trail follows the edge
+// directions. Vertices without edges are ignored, and a graph without edges has an
+// empty circuit.
+func HasEulerianCircuit[V comparable, N constraints.Number](g Graph[V, N]) bool {
+	_, ok := eulerianStart(g, true)
+	return ok
+}
+
+// EulerianPath returns an iter.Seq[tuples.Edge[V, N]] over a trail of the graph g that
+// uses every edge exactly once, in the order the trail walks them. If g has an
+// Eulerian circuit, the trail is closed. The edges of undirected graphs are oriented
+// in the direction the trail walks them, and parallel edges are each walked once.
+// If g has no Eulerian trail, an empty sequence and false are returned.
+// The algorithm used is Hierholzer's algorithm, which runs in O(E) time.
+func EulerianPath[V comparable, N constraints.Number](g Graph[V, N]) (iter.Seq[tuples.Edge[V, N]], bool) {
+	start, ok := eulerianStart(g, false)
+	if !ok {
+		return func(yield func(tuples.Edge[V, N]) bool) {}, false
+	}
+
+	edges := slices.Collect(g.Edges())
+	adj := map[V][]int{}
+	for i, e := range edges {
+		adj[e.Src()] = append(adj[e.Src()], i)
+		if !g.IsDirected() && e.Src() != e.Dst() {
+			adj[e.Dst()] = append(adj[e.Dst()], i)
+		}
+	}
+
+	// every entry of the stack is a vertex along with the edge used to reach it
+	type entry struct {
+		v    V
+		edge int
+	}
+	used := make([]bool, len(edges))
+	stack := []entry{{start, -1}}
+	trail := make([]tuples.Edge[V, N], 0, len(edges))
+	for len(stack) > 0 {
+		top := stack[len(stack)-1]
+		next := adj[top.v]
+		for len(next) > 0 && used[next[len(next)-1]] {
+			next = next[:len(next)-1]
+		}
+		adj[top.v] = next
+		if len(next) > 0 {
+			i := next[len(next)-1]
+			used[i] = true
+			dst := edges[i].Dst()
+			if dst == top.v {
+				dst = edges[i].Src()
+			}
+			stack = append(stack, entry{dst, i})
+			continue
+		}
+
+		// every edge of top.v is used, so its incoming edge closes the trail
+		stack = stack[:len(stack)-1]
+		if top.edge != -1 {
+			e := edges[top.edge]
+			trail = append(trail, tuples.NewEdge(stack[len(stack)-1].v, top.v, e.Weight()))
+		}
+	}
+	slices.Reverse(trail)
+
+	return slices.Values(trail), true
+}
+
+// eulerianStart returns the vertex an Eulerian trail of g must start from, or an
+// Eulerian circuit if closed is true, and whether such a trail exists. If g has no
+// edges, the zero value and true are returned.
+func eulerianStart[V comparable, N constraints.Number](g Graph[V, N], closed bool) (V, bool) {
+	// balance is the out-degree minus the in-degree in directed graphs and the degree
+	// in undirected graphs, where a self-loop counts twice
+	balance := map[V]int{}
+	uf := unionfind.New[V]()
+	var start, first V
+	for e := range g.Edges() {
+		src, dst := e.Src(), e.Dst()
+		if len(balance) == 0 {
+			start, first = src, src
+		}
+		for _, v := range []V{src, dst} {
+			if _, ok := balance[v]; !ok {
+				balance[v] = 0
+				uf.MakeSet(v)
+			}
+		}
+		if g.IsDirected() {
+			balance[src]++
+			balance[dst]--
+		} else {
+			balance[src]++
+			balance[dst]++
+		}
+		uf.Union(src, dst)
+	}
+
+	odd := 0
+	for v, b := range balance {
+		if !uf.Connected(v, first) {
+			return start, false
+		}
+		switch {
+		case g.IsDirected() && b == 1:
+			start = v
+			odd++
+		case g.IsDirected() && b != 0 && b != -1:
+			return start, false
+		case !g.IsDirected() && b%2 == 1:
+			if odd == 0 {
+				start = v
+			}
+			odd++
+		}
+	}
+
+	if closed {
+		return start, odd == 0
+	}
+	if g.IsDirected() {
+		// the balances add up to zero, so a single vertex with one more outgoing
+		// edge comes with a single vertex with one more incoming edge
+		return start, odd <= 1
+	}
+	return start, odd <= 2
+}
@@ -0,0 +1,78 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/elordeiro/goext/containers/graph"
+	"github.com/elordeiro/goext/containers/hashgraph"
+	"github.com/elordeiro/goext/containers/tuples"
+)
+
+func TestEulerianPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		directed bool
+		edges    [][2]int
+		path     bool
+		circuit  bool
+	}{
+		{"empty", false, nil, true, true},
+		{"triangle", false, [][2]int{{1, 2}, {2, 3}, {3, 1}}, true, true},
+		{"house", false, [][2]int{{1, 2}, {2, 3}, {3, 4}, {4, 1}, {1, 5}, {5, 2}, {1, 3}}, true, false},
+		{"star", false, [][2]int{{1, 2}, {1, 3}, {1, 4}}, false, false},
+		{"bowtie", false, [][2]int{{1, 2}, {2, 3}, {3, 1}, {3, 4}, {4, 5}, {5, 3}}, true, true},
+		{"self-loop", false, [][2]int{{1, 2}, {2, 2}, {2, 3}}, true, false},
+		{"disconnected", false, [][2]int{{1, 2}, {2, 3}, {3, 1}, {4, 5}, {5, 6}, {6, 4}}, false, false},
+		{"directed cycle", true, [][2]int{{1, 2}, {2, 3}, {3, 1}}, true, true},
+		{"directed chain", true, [][2]int{{1, 2}, {2, 3}, {3, 1}, {1, 4}}, true, false},
+		{"directed split", true, [][2]int{{1, 2}, {1, 3}}, false, false},
+		{"directed pair", true, [][2]int{{1, 2}, {2, 1}, {2, 3}, {3, 2}, {3, 1}}, true, false},
+	}
+
+	for _, tc := range tests {
+		g := hashgraph.New[int, int](tc.directed)
+		for _, e := range tc.edges {
+			g.AddEdge(e[0], e[1], e[0]*10+e[1])
+		}
+
+		t.Run(tc.name, func(t *testing.T) {
+			if got := graph.HasEulerianPath(g); got != tc.path {
+				t.Errorf("HasEulerianPath(%v) = %v, want %v", g, got, tc.path)
+			}
+			if got := graph.HasEulerianCircuit(g); got != tc.circuit {
+				t.Errorf("HasEulerianCircuit(%v) = %v, want %v", g, got, tc.circuit)
+			}
+
+			trail, ok := graph.EulerianPath(g)
+			if ok != tc.path {
+				t.Fatalf("EulerianPath(%v) ok = %v, want %v", g, ok, tc.path)
+			}
+			if !ok {
+				return
+			}
+
+			// every edge is walked once, with consecutive edges sharing a vertex
+			walked := map[[2]int]int{}
+			var prev tuples.Edge[int, int]
+			n := 0
+			for e := range trail {
+				if n > 0 && prev.Dst() != e.Src() {
+					t.Fatalf("EulerianPath(%v) walks %v after %v", g, e, prev)
+				}
+				key := [2]int{e.Src(), e.Dst()}
+				if !tc.directed && key[0] > key[1] {
+					key[0], key[1] = key[1], key[0]
+				}
+				if w, _ := g.EdgeWeight(e.Src(), e.Dst()); w != e.Weight() {
+					t.Errorf("EulerianPath(%v) walks %v, want weight %v", g, e, w)
+				}
+				walked[key]++
+				prev = e
+				n++
+			}
+			if n != len(tc.edges) || len(walked) != len(tc.edges) {
+				t.Errorf("EulerianPath(%v) walks %v edges (%v distinct), want %v", g, n, len(walked), len(tc.edges))
+			}
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package graph
+
+import (
+	"iter"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/deque"
+	"github.com/elordeiro/goext/containers/set"
+	"github.com/elordeiro/goext/containers/tuples"
+	extMath "github.com/elordeiro/goext/math"
+)
+
+// network is the residual network used by the flow algorithms in this package.
+// Vertices are mapped to indices and arcs are stored in pairs, so that arc a and
+// arc a^1 are the reverse of each other. For directed graphs the reverse of an
+// edge starts with no capacity, for undirected graphs both arcs start with the
+// capacity of the edge.
+type network[V comparable, N constraints.Number] struct {
+	index    map[V]int
+	vertices []V
+	adj      [][]int // arcs leaving each vertex
+	from, to []int
+	res      []N // residual capacity of each arc
+	capacity []N // capacity of the edge each arc was built from
+	cost     []N // cost of sending one unit of flow through each arc
+}
+
+// addArc adds an arc from u to v along with its reverse arc to the network.
+func (nw *network[V, N]) addArc(u, v int, capacity, reverseCapacity, cost N) {
+	nw.appendArc(u, v, capacity, cost)
+	nw.appendArc(v, u, reverseCapacity, -cost)
+}
+
+// appendArc appends a single arc from u to v to the network.
+func (nw *network[V, N]) appendArc(u, v int, capacity, cost N) {
+	nw.adj[u] = append(nw.adj[u], len(nw.to))
+	nw.from = append(nw.from, u)
+	nw.to = append(nw.to, v)
+	nw.res = append(nw.res, capacity)
+	nw.capacity = append(nw.capacity, capacity)
+	nw.cost = append(nw.cost, cost)
+}
+
+// newNetwork returns the residual network of the graph g with no flow. If costs is
+// not nil, the arcs are built from the edges it yields instead of the edges of g,
+// every arc is given the cost of its edge and every undirected edge is turned into
+// two independent directed edges.
+func newNetwork[V comparable, N constraints.Number](g Graph[V, N], costs iter.Seq[tuples.CostEdge[V, N]]) *network[V, N] {
+	nw := &network[V, N]{index: map[V]int{}}
+	for v := range g.Vertices() {
+		nw.index[v] = len(nw.vertices)
+		nw.vertices = append(nw.vertices, v)
+	}
+	nw.adj = make([][]int, len(nw.vertices))
+
+	if costs != nil {
+		for e := range costs {
+			u, v := nw.index[e.Src()], nw.index[e.Dst()]
+			if u == v {
+				continue
+			}
+			nw.addArc(u, v, e.Weight(), 0, e.Cost())
+			if !g.IsDirected() {
+				nw.addArc(v, u, e.Weight(), 0, e.Cost())
+			}
+		}
+		return nw
+	}
+
+	for e := range g.Edges() {
+		u, v, w := nw.index[e.Src()], nw.index[e.Dst()], e.Weight()
+		switch {
+		case u == v:
+			continue
+		case g.IsDirected():
+			nw.addArc(u, v, w, 0, 0)
+		default:
+			nw.addArc(u, v, w, w, 0)
+		}
+	}
+	return nw
+}
+
+// flow returns the flow going through the arc a. For undirected graphs the flow
+// is negative when it goes in the opposite direction of the arc.
+func (nw *network[V, N]) flow(a int) N {
+	return nw.capacity[a] - nw.res[a]
+}
+
+// dinic saturates the network with the maximum flow from s to t using Dinic's
+// algorithm and returns the value of that flow.
+func (nw *network[V, N]) dinic(s, t int) N {
+	n := len(nw.vertices)
+	level := make([]int, n)
+	next := make([]int, n) // next arc to try for each vertex in the level graph
+
+	bfs := func() bool {
+		for i := range level {
+			level[i] = -1
+		}
+		level[s] = 0
+		q := deque.New(s)
+		for !q.IsEmpty() {
+			u := q.PopFront()
+			for _, a := range nw.adj[u] {
+				if v := nw.to[a]; nw.res[a] > 0 && level[v] < 0 {
+					level[v] = level[u] + 1
+					q.PushBack(v)
+				}
+			}
+		}
+		return level[t] >= 0
+	}
+
+	var dfs func(u int, limit N) N
+	dfs = func(u int, limit N) N {
+		if u == t {
+			return limit
+		}
+		for ; next[u] < len(nw.adj[u]); next[u]++ {
+			a := nw.adj[u][next[u]]
+			v := nw.to[a]
+			if nw.res[a] <= 0 || level[v] != level[u]+1 {
+				continue
+			}
+			if pushed := dfs(v, min(limit, nw.res[a])); pushed > 0 {
+				nw.res[a] -= pushed
+				nw.res[a^1] += pushed
+				return pushed
+			}
+		}
+		return 0
+	}
+
+	var total N
+	inf := extMath.Inf[N]()
+	for bfs() {
+		clear(next)
+		for pushed := dfs(s, inf); pushed > 0; pushed = dfs(s, inf) {
+			total += pushed
+		}
+	}
+	return total
+}
+
+// edges returns an iter.Seq[tuples.Edge[V, N]] over the edges of the original graph
+// that carry flow, weighted by the amount of flow.
+func (nw *network[V, N]) edges() iter.Seq[tuples.Edge[V, N]] {
+	return func(yield func(tuples.Edge[V, N]) bool) {
+		for a := 0; a < len(nw.to); a += 2 {
+			u, v, f := nw.vertices[nw.from[a]], nw.vertices[nw.to[a]], nw.flow(a)
+			if f < 0 {
+				u, v, f = v, u, -f
+			}
+			if f > 0 && !yield(tuples.NewEdge(u, v, f)) {
+				return
+			}
+		}
+	}
+}
+
+// MaxFlow returns the value of the maximum flow from the vertex source to the vertex
+// sink in the graph g, where the weight of every edge is its capacity, along with an
+// iter.Seq[tuples.Edge[V, N]] over the edges that carry flow, weighted by the amount of
+// flow going through them. In undirected graphs flow can go through an edge in either
+// direction, and the yielded edge is oriented in the direction of the flow.
+// The algorithm used is Dinic's algorithm.
+func MaxFlow[V comparable, N constraints.Number](
+	g Graph[V, N],
+	source, sink V,
+) (N, iter.Seq[tuples.Edge[V, N]]) {
+	nw := newNetwork(g, nil)
+	s, ok1 := nw.index[source]
+	t, ok2 := nw.index[sink]
+	if !ok1 || !ok2 || s == t {
+		return 0, func(yield func(tuples.Edge[V, N]) bool) {}
+	}
+	return nw.dinic(s, t), nw.edges()
+}
+
+// MinCut returns a minimum cut between the vertex source and the vertex sink in the
+// graph g, where the weight of every edge is its capacity. The cut is returned as the
+// set of vertices on the source side along with an iter.Seq[tuples.Edge[V, N]] over
+// the edges that cross from the source side to the sink side. The total weight of
+// those edges equals the value of the maximum flow.
+func MinCut[V comparable, N constraints.Number](
+	g Graph[V, N],
+	source, sink V,
+) (set.Set[V], iter.Seq[tuples.Edge[V, N]]) {
+	nw := newNetwork(g, nil)
+	s, ok1 := nw.index[source]
+	t, ok2 := nw.index[sink]
+	if !ok1 || !ok2 || s == t {
+		return set.New[V](), func(yield func(tuples.Edge[V, N]) bool) {}
+	}
+	nw.dinic(s, t)
+
+	// the source side is whatever can still be reached in the residual network
+	side := make([]bool, len(nw.vertices))
+	side[s] = true
+	q := deque.New(s)
+	for !q.IsEmpty() {
+		u := q.PopFront()
+		for _, a := range nw.adj[u] {
+			if v := nw.to[a]; nw.res[a] > 0 && !side[v] {
+				side[v] = true
+				q.PushBack(v)
+			}
+		}
+	}
+
+	sourceSide := set.New[V]()
+	for i, v := range nw.vertices {
+		if side[i] {
+			sourceSide.Add(v)
+		}
+	}
+
+	return sourceSide, func(yield func(tuples.Edge[V, N]) bool) {
+		for a := range nw.to {
+			if nw.capacity[a] == 0 || !side[nw.from[a]] || side[nw.to[a]] {
+				continue
+			}
+			if !yield(tuples.NewEdge(nw.vertices[nw.from[a]], nw.vertices[nw.to[a]], nw.capacity[a])) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/elordeiro/goext/containers/graph"
+	"github.com/elordeiro/goext/containers/hashgraph"
+	"github.com/elordeiro/goext/containers/set"
+)
+
+func TestMaxFlow(t *testing.T) {
+	tests := []struct {
+		name         string
+		edges        [][3]int
+		directed     bool
+		source, sink int
+		want         int
+	}{
+		{
+			"clrs",
+			[][3]int{{0, 1, 16}, {0, 2, 13}, {1, 3, 12}, {2, 1, 4}, {2, 4, 14}, {3, 2, 9}, {3, 5, 20}, {4, 3, 7}, {4, 5, 4}},
+			true, 0, 5, 23,
+		},
+		{
+			"antiparallel edges",
+			[][3]int{{0, 1, 3}, {1, 0, 2}, {1, 2, 2}, {0, 2, 1}},
+			true, 0, 2, 3,
+		},
+		{
+			"no path",
+			[][3]int{{0, 1, 3}, {2, 1, 3}},
+			true, 0, 2, 0,
+		},
+		{
+			"undirected",
+			[][3]int{{0, 1, 3}, {0, 2, 2}, {1, 2, 5}, {1, 3, 2}, {2, 3, 3}},
+			false, 0, 3, 5,
+		},
+		{
+			"undirected flow against edge order",
+			[][3]int{{1, 0, 4}, {2, 1, 4}},
+			false, 0, 2, 4,
+		},
+	}
+
+	for _, tc := range tests {
+		g := hashgraph.New[int, int](tc.directed)
+		for _, e := range tc.edges {
+			g.AddEdge(e[0], e[1], e[2])
+		}
+
+		t.Run(tc.name, func(t *testing.T) {
+			got, flows := graph.MaxFlow(g, tc.source, tc.sink)
+			if got != tc.want {
+				t.Errorf("MaxFlow(%v, %v, %v) = %v, want %v", g, tc.source, tc.sink, got, tc.want)
+			}
+
+			// every edge respects its capacity and flow is conserved at every
+			// vertex other than the source and the sink
+			balance := map[int]int{}
+			for e := range flows {
+				capacity, err := g.EdgeWeight(e.Src(), e.Dst())
+				if err != nil || e.Weight() > capacity {
+					t.Errorf("MaxFlow(%v) flow %v exceeds capacity %v", g, e, capacity)
+				}
+				balance[e.Src()] -= e.Weight()
+				balance[e.Dst()] += e.Weight()
+			}
+			for v, b := range balance {
+				if v != tc.source && v != tc.sink && b != 0 {
+					t.Errorf("MaxFlow(%v) flow is not conserved at %v", g, v)
+				}
+			}
+			if balance[tc.sink] != tc.want {
+				t.Errorf("MaxFlow(%v) flow into sink = %v, want %v", g, balance[tc.sink], tc.want)
+			}
+
+			side, cut := graph.MinCut(g, tc.source, tc.sink)
+			if !side.Contains(tc.source) || side.Contains(tc.sink) {
+				t.Errorf("MinCut(%v, %v, %v) = %v, want source side", g, tc.source, tc.sink, side)
+			}
+			total := 0
+			for e := range cut {
+				if !side.Contains(e.Src()) || side.Contains(e.Dst()) {
+					t.Errorf("MinCut(%v) edge %v does not cross the cut", g, e)
+				}
+				total += e.Weight()
+			}
+			if total != tc.want {
+				t.Errorf("MinCut(%v) weight = %v, want %v", g, total, tc.want)
+			}
+		})
+	}
+}
+
+func TestMinCutSide(t *testing.T) {
+	g := hashgraph.New[string, float64](true)
+	g.AddEdge("s", "a", 10)
+	g.AddEdge("s", "b", 10)
+	g.AddEdge("a", "b", 2)
+	g.AddEdge("a", "t", 1.5)
+	g.AddEdge("b", "t", 2.5)
+
+	side, _ := graph.MinCut(g, "s", "t")
+	if want := set.New("s", "a", "b"); !side.Equal(want) {
+		t.Errorf("MinCut(%v) = %v, want %v", g, side, want)
+	}
+	if got, _ := graph.MaxFlow(g, "s", "t"); got != 4 {
+		t.Errorf("MaxFlow(%v) = %v, want 4", g, got)
+	}
+}
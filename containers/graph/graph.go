@@ -1,6 +1,8 @@
 package graph
 
 import (
+	"cmp"
+	"context"
 	"iter"
 	"math"
 	"slices"
@@ -13,17 +15,24 @@ import (
 	"github.com/elordeiro/goext/containers/tuples/ituples"
 	"github.com/elordeiro/goext/containers/unionfind"
 	"github.com/elordeiro/goext/containers/vector"
-	extMath "github.com/elordeiro/goext/math"
 	"github.com/elordeiro/goext/seqs"
 )
 
 // Graph is an interface that defines the methods that a graph must implement
 // to be used by the algorithms in this package
 type Graph[V comparable, N constraints.Number] interface {
+	Traversable[V, N]
 	IsDirected() bool
 	Edges() iter.Seq[tuples.Edge[V, N]]
 	VertexCount() int
 	Vertices() iter.Seq[V]
+}
+
+// Traversable is an interface that defines the methods that a graph must implement
+// to be searched from a start vertex by the traversal algorithms in this package,
+// such as DFS, BFS, Dijkstra and AStar. It allows searching graphs whose vertices
+// cannot be listed, see ImplicitGraph.
+type Traversable[V comparable, N constraints.Number] interface {
 	Neighbors(V) iter.Seq2[V, N]
 }
 
@@ -38,6 +47,12 @@ type pathFindOptions[V comparable] struct {
 	vertexFilter, earlyReturn func(V, V) bool
 	preVisit                  func(V, V)
 	deferred                  func(V)
+
+	// search budgets, see limit
+	ctx                   context.Context
+	maxExpanded, maxDepth int
+	pruned                bool
+	err                   error
 }
 
 type heuristic[V any, N constraints.Number] func(V) N
@@ -80,6 +95,25 @@ func PreVisitOption[V comparable](process func(src, dst V)) option[V] {
 	}
 }
 
+// MaxExpandedOption returns an option that stops the path finding algorithms once
+// they have expanded n vertices. The search then returns the path to the next vertex
+// it would have expanded. Use the Context variants of the algorithms, such as
+// DijkstraContext, to tell such a path apart from one that reached its goal.
+func MaxExpandedOption[V comparable](n int) option[V] {
+	return func(o *pathFindOptions[V]) {
+		o.maxExpanded = n
+	}
+}
+
+// MaxDepthOption returns an option that keeps the path finding algorithms from
+// following paths with more than depth edges. The depth of a vertex is counted
+// along the path the search took to reach it.
+func MaxDepthOption[V comparable](depth int) option[V] {
+	return func(o *pathFindOptions[V]) {
+		o.maxDepth = depth
+	}
+}
+
 // // PostVisitOption returns an option that allows for a process to be executed
 // // after the algorithm visits the vertex. This option cannot modify the current path.
 // // User may pass in a VisitedFilterOption() if they wish to modify the path with
@@ -109,6 +143,42 @@ func EuclideanHeuristic[P ituples.Point2d[N], N constraints.Number](end P) heuri
 	}
 }
 
+// ManhattanHeuristic returns a heuristic function that calculates the Manhattan
+// distance between two cells of a grid. It suits grids with 4-way movement.
+func ManhattanHeuristic[C ituples.Cell[N], N constraints.Number](end C) heuristic[C, N] {
+	return func(src C) N {
+		return absDiff(src.Row(), end.Row()) + absDiff(src.Col(), end.Col())
+	}
+}
+
+// ChebyshevHeuristic returns a heuristic function that calculates the Chebyshev
+// distance between two cells of a grid. It suits grids with 8-way movement where
+// diagonal moves cost the same as orthogonal ones.
+func ChebyshevHeuristic[C ituples.Cell[N], N constraints.Number](end C) heuristic[C, N] {
+	return func(src C) N {
+		return max(absDiff(src.Row(), end.Row()), absDiff(src.Col(), end.Col()))
+	}
+}
+
+// OctileHeuristic returns a heuristic function that calculates the octile distance
+// between two cells of a grid. It suits grids with 8-way movement where diagonal
+// moves cost math.Sqrt2 times as much as orthogonal ones.
+func OctileHeuristic[C ituples.Cell[N], N constraints.Number](end C) heuristic[C, float64] {
+	return func(src C) float64 {
+		dr := float64(absDiff(src.Row(), end.Row()))
+		dc := float64(absDiff(src.Col(), end.Col()))
+		return max(dr, dc) + (math.Sqrt2-1)*min(dr, dc)
+	}
+}
+
+// absDiff returns the absolute difference between a and b.
+func absDiff[N constraints.Number](a, b N) N {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
 // setOptions returns a new pathFindOptions with the given options. If no options
 // are given, the default options are returned.
 func setOptions[V comparable](opts ...option[V]) *pathFindOptions[V] {
@@ -123,20 +193,21 @@ func setOptions[V comparable](opts ...option[V]) *pathFindOptions[V] {
 	for _, opt := range opts {
 		opt(options)
 	}
+	options.limit()
 	return options
 }
 
 // Path returns the a path between two vertices. The path is returned as a
 // iter.Seq[tuples.Edge[V, N]] If no path exists, an empty sequence is returned.
 // The algorithm used is depth-first search.
-func Path[V comparable, N constraints.Number](g Graph[V, N], src, dst V) iter.Seq[tuples.Edge[V, N]] {
+func Path[V comparable, N constraints.Number](g Traversable[V, N], src, dst V) iter.Seq[tuples.Edge[V, N]] {
 	baseCase := BaseCaseOption(func(src V) bool { return src == dst })
 	return DFS(g, src, baseCase)
 }
 
 // HasPath returns true if there is a path between two vertices and false otherwise.
 // The algorithm used is depth-first search.
-func HasPath[V comparable, N constraints.Number](g Graph[V, N], src, dst V) bool {
+func HasPath[V comparable, N constraints.Number](g Traversable[V, N], src, dst V) bool {
 	baseCase := BaseCaseOption(func(src V) bool { return src == dst })
 	return !seqs.IsEmpty(DFS(g, src, baseCase))
 }
@@ -144,7 +215,7 @@ func HasPath[V comparable, N constraints.Number](g Graph[V, N], src, dst V) bool
 // ShortestPath returns the shortest path between two vertices. The path is returned as a
 // iter.Seq[tuples.Edges[V, N]]. If no path exists, an empty sequence is returned.
 // The algorithm used is breadth-first search. For a faster algorithm, use Dijkstra or AStar.
-func ShortesPath[V comparable, N constraints.Number](g Graph[V, N], src, dst V) iter.Seq[tuples.Edge[V, N]] {
+func ShortesPath[V comparable, N constraints.Number](g Traversable[V, N], src, dst V) iter.Seq[tuples.Edge[V, N]] {
 	baseCase := BaseCaseOption(func(src V) bool { return src == dst })
 	return BFS(g, src, baseCase)
 }
@@ -224,7 +295,7 @@ func HasCycle[V comparable, N constraints.Number](g Graph[V, N]) bool {
 //   - PostVisitOpton: execute a process with src and dst after visiting a vertex.
 //   - DeferredOption: execute a process with a source vertex if it does not satisfy the base case.
 func DFS[V comparable, N constraints.Number](
-	g Graph[V, N],
+	g Traversable[V, N],
 	start V,
 	options ...option[V],
 ) iter.Seq[tuples.Edge[V, N]] {
@@ -277,7 +348,7 @@ func DFS[V comparable, N constraints.Number](
 //   - WithDeferred: execute a process with a source vertex if it does not
 //     satisfy the base case.
 func BFS[V comparable, N constraints.Number](
-	g Graph[V, N],
+	g Traversable[V, N],
 	start V,
 	options ...option[V],
 ) iter.Seq[tuples.Edge[V, N]] {
@@ -321,7 +392,7 @@ func BFS[V comparable, N constraints.Number](
 // Dijkstra performs a Dijkstra search on the graph g starting from the vertex start.
 // The algorithm will return an iter.Seq[tuples.Edge[V, N]] that represents the path
 // from the start vertex to the destination vertex. If no path exists, an empty sequence
-// is returned.
+// is returned. Edge weights must not be negative, use BellmanFord or SPFA otherwise.
 // The algorithm can be configured with the following options:
 //
 //   - BaseCaseOption: return true if searching should stop, false otherwise.
@@ -333,51 +404,75 @@ func BFS[V comparable, N constraints.Number](
 //   - PostVisitOpton: execute a process with src and dst after visiting a vertex.
 //   - DeferredOption: execute a process with a source vertex if it does not satisfy the base case.
 func Dijkstra[V comparable, N constraints.Number](
-	g Graph[V, N],
+	g Traversable[V, N],
 	start V,
 	options ...option[V],
 ) iter.Seq[tuples.Edge[V, N]] {
-	opts := setOptions(options...)
+	_, prev, end, ok := dijkstraAll(g, start, setOptions(options...))
+	if !ok {
+		// no path was found
+		return func(yield func(tuples.Edge[V, N]) bool) {}
+	}
+	return rebuildPath(end, start, prev)
+}
 
+// dijkstraAll runs Dijkstra's algorithm from the vertex start until every reachable
+// vertex has been settled, or until the search is stopped by a settled vertex that
+// satisfies the base case or the early return option. It returns the distance and
+// predecessor maps, along with the vertex the search stopped at and true, or false if
+// it was not stopped. Vertices whose distance is not final are not present in either
+// map.
+func dijkstraAll[V comparable, N constraints.Number](
+	g Traversable[V, N],
+	start V,
+	opts *pathFindOptions[V],
+) (map[V]N, map[V]tuples.Pair[V, N], V, bool) {
 	pq := pq.NewPQFunc(func(p1, p2 tuples.Pair[V, N]) bool {
 		return p1.Right() < p2.Right()
 	}, tuples.NewPair(start, N(0)))
 
 	prev := map[V]tuples.Pair[V, N]{}
-	dist := map[V]N{}
-
-	inf := extMath.Inf[N]()
-	for v := range g.Vertices() {
-		dist[v] = inf
+	dist := map[V]N{start: 0}
+	settled := set.New[V]()
+
+	// stop drops the vertices whose distance is not final yet, which are the ones
+	// that are neither settled nor end
+	stop := func(end V) (map[V]N, map[V]tuples.Pair[V, N], V, bool) {
+		for v := range dist {
+			if v != end && !settled.Contains(v) {
+				delete(dist, v)
+				delete(prev, v)
+			}
+		}
+		return dist, prev, end, true
 	}
-	dist[start] = 0
 
 	for !pq.IsEmpty() {
 		src := pq.Pop().Left()
-		if _, ok := dist[src]; !ok {
+		if settled.Contains(src) {
 			continue
 		}
 		if opts.baseCase(src) {
-			return rebuildPath(src, start, prev)
+			return stop(src)
 		}
 		for dst, w := range g.Neighbors(src) {
-			if _, ok := dist[dst]; ok && opts.vertexFilter(src, dst) {
-				if dist[dst] > dist[src]+w {
+			if !settled.Contains(dst) && opts.vertexFilter(src, dst) {
+				if d, ok := dist[dst]; !ok || d > dist[src]+w {
 					opts.preVisit(src, dst)
 					dist[dst] = dist[src] + w
 					prev[dst] = tuples.NewPair(src, w)
 					pq.Push(tuples.NewPair(dst, dist[dst]))
 				}
 			} else if opts.earlyReturn(src, dst) {
-				return rebuildPath(src, start, prev)
+				return stop(src)
 			}
 		}
 		opts.deferred(src)
-		delete(dist, src)
+		settled.Add(src)
 	}
 
-	// no path was found
-	return func(yield func(tuples.Edge[V, N]) bool) {}
+	var zero V
+	return dist, prev, zero, false
 }
 
 // AStar performs an A* search on the graph g starting from the vertex start and
@@ -395,7 +490,7 @@ func Dijkstra[V comparable, N constraints.Number](
 //   - PostVisitOpton: execute a process with src and dst after visiting a vertex.
 //   - DeferredOption: execute a process with a source vertex if it does not satisfy the base case.
 func AStar[V comparable, N constraints.Number](
-	g Graph[V, N],
+	g Traversable[V, N],
 	start V,
 	h heuristic[V, N],
 	options ...option[V],
@@ -404,8 +499,7 @@ func AStar[V comparable, N constraints.Number](
 
 	visited := set.New[V]()
 	prev := map[V]tuples.Pair[V, N]{}
-	gScore := map[V]N{}
-	fScore := map[V]N{}
+	gScore := map[V]N{start: 0}
 
 	pq := pq.NewPQFunc(func(p1, p2 tuples.Pair[V, N]) bool {
 		return p1.Right() < p2.Right()
@@ -413,14 +507,6 @@ func AStar[V comparable, N constraints.Number](
 
 	f := func(src V) N { return gScore[src] + h(src) }
 
-	inf := extMath.Inf[N]()
-	for v := range g.Vertices() {
-		fScore[v] = inf
-		gScore[v] = inf
-	}
-	gScore[start] = 0
-	fScore[start] = h(start)
-
 	for !pq.IsEmpty() {
 		src := pq.Pop().Left()
 		if opts.baseCase(src) {
@@ -429,13 +515,12 @@ func AStar[V comparable, N constraints.Number](
 		visited.Add(src)
 		for dst, w := range g.Neighbors(src) {
 			tentative := gScore[src] + w
-			if tentative < gScore[dst] {
+			if score, ok := gScore[dst]; !ok || tentative < score {
 				prev[dst] = tuples.NewPair(src, w)
 				gScore[dst] = tentative
-				fScore[dst] = f(dst)
 				if !visited.Contains(dst) && opts.vertexFilter(src, dst) {
 					opts.preVisit(src, dst)
-					pq.Push(tuples.NewPair(dst, fScore[dst]))
+					pq.Push(tuples.NewPair(dst, f(dst)))
 				} else if opts.earlyReturn(src, dst) {
 					return rebuildPath(src, start, prev)
 				}
@@ -466,7 +551,7 @@ func Kruskal[V comparable, N constraints.Number](g Graph[V, N]) iter.Seq[tuples.
 	}
 
 	slices.SortFunc(vec, func(a, b tuples.Edge[V, N]) int {
-		return int(float64(b.Weight()) - float64(a.Weight()))
+		return cmp.Compare(b.Weight(), a.Weight())
 	})
 
 	mst := vector.New[tuples.Edge[V, N]]()
@@ -658,11 +743,6 @@ func Edmonds[V comparable, N constraints.Number](g Graph[V, N], r V) iter.Seq[tu
 	}
 }
 
-// Needs to implement generic solution using the method used in:
-// https://github.com/elordeiro/competitive-programming/blob/main/go/directedmst/main.go
-// func Tarjan[V comparable, N constraints.Number](g Graph[V, N], root V) iter.Seq[tuples.Edge[V, N]] {
-// }
-
 // rebuildPath returns a function that builds a path from the source vertex to the
 // destination vertex. The path is built using the prev map.
 func rebuildPath[V comparable, N constraints.Number](
@@ -0,0 +1,87 @@
+package graph_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/elordeiro/goext/containers/csrgraph"
+	"github.com/elordeiro/goext/containers/graph"
+	"github.com/elordeiro/goext/containers/hashgraph"
+	"github.com/elordeiro/goext/containers/matrixgraph"
+	"github.com/elordeiro/goext/containers/tuples"
+)
+
+const (
+	benchVertices = 1000
+	sparseDegree  = 8
+	denseDegree   = 500
+)
+
+// generateRandomEdges generates n*degree random directed edges between the vertices 0
+// to n-1
+func generateRandomEdges(n, degree int) []tuples.Edge[int, int] {
+	r := rand.New(rand.NewPCG(1, 2))
+	edges := make([]tuples.Edge[int, int], 0, n*degree)
+	for src := range n {
+		for range degree {
+			edges = append(edges, tuples.NewEdge(src, r.IntN(n), 1+r.IntN(100)))
+		}
+	}
+	return edges
+}
+
+// benchGraphs returns the same random graph stored as a HashGraph, a MatrixGraph and
+// a CSRGraph
+func benchGraphs(degree int) map[string]graph.Graph[int, int] {
+	edges := generateRandomEdges(benchVertices, degree)
+	h := hashgraph.New[int, int](true)
+	for v := range benchVertices {
+		h.AddVertex(v)
+	}
+	for _, e := range edges {
+		h.AddEdge(e.Src(), e.Dst(), e.Weight())
+	}
+	return map[string]graph.Graph[int, int]{
+		"HashGraph":   h,
+		"MatrixGraph": matrixgraph.FromEdges(benchVertices, true, edges...),
+		"CSRGraph":    csrgraph.New(benchVertices, true, edges...),
+	}
+}
+
+func benchmarkSearch(b *testing.B, degree int, search func(graph.Graph[int, int])) {
+	graphs := benchGraphs(degree)
+	for _, name := range []string{"HashGraph", "MatrixGraph", "CSRGraph"} {
+		g := graphs[name]
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				search(g)
+			}
+		})
+	}
+}
+
+func dijkstraSearch(g graph.Graph[int, int]) {
+	for range graph.Dijkstra(g, 0) {
+	}
+}
+
+func bfsSearch(g graph.Graph[int, int]) {
+	for range graph.BFS(g, 0) {
+	}
+}
+
+func BenchmarkDijkstraSparse(b *testing.B) {
+	benchmarkSearch(b, sparseDegree, dijkstraSearch)
+}
+
+func BenchmarkDijkstraDense(b *testing.B) {
+	benchmarkSearch(b, denseDegree, dijkstraSearch)
+}
+
+func BenchmarkBFSSparse(b *testing.B) {
+	benchmarkSearch(b, sparseDegree, bfsSearch)
+}
+
+func BenchmarkBFSDense(b *testing.B) {
+	benchmarkSearch(b, denseDegree, bfsSearch)
+}
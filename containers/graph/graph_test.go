@@ -1,6 +1,8 @@
 package graph_test
 
 import (
+	"errors"
+	"math"
 	"slices"
 	"testing"
 
@@ -244,6 +246,22 @@ func TestDijkstra(t *testing.T) {
 	}
 }
 
+func TestDijkstraSelfLoop(t *testing.T) {
+	g := hashgraph.New[int, int](true)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 2, 1)
+	g.AddEdge(2, 3, 1)
+	g.AddEdge(3, 1, 1)
+
+	// a vertex is settled once its edges have been scanned, so its self-loop is
+	// relaxed like any other edge and the early return only fires at 3 -> 1
+	back := graph.EarlyReturnOption(func(src, dst int) bool { return dst <= src })
+	want := slices.Values(tuples.Edges([3]int{1, 2, 1}, [3]int{2, 3, 1}))
+	if got := graph.Dijkstra(g, 1, back); !seqs.Equal(got, want) {
+		t.Errorf("Dijkstra(g, 1, back) = %s, want %s", seqs.String(got), seqs.String(want))
+	}
+}
+
 func TestAStar(t *testing.T) {
 	tests := []struct {
 		start, end int
@@ -372,143 +390,166 @@ func TestKruskal(t *testing.T) {
 	}
 }
 
-// Waiting on implementation of Tarjan function.
-// func TestTarjan(t *testing.T) {
-// 	testOnly := 0
-// 	tests := []struct {
-// 		edges  [][3]int
-// 		want   []set.Set[tuples.Edge[int, int]]
-// 		weight int
-// 		name   string
-// 	}{
-// 		{
-// 			[][3]int{{1, 2, 1}, {1, 3, 4}, {2, 3, 2}, {2, 4, 5}, {3, 4, 1}},
-// 			[]set.Set[tuples.Edge[int, int]]{set.New(tuples.Edges([][3]int{{1, 2, 1}, {2, 3, 2}, {3, 4, 1}}...)...)},
-// 			4,
-// 			"simple graph",
-// 		},
-// 		{
-// 			[][3]int{{1, 2, 2}, {2, 3, 2}, {3, 4, 2}, {4, 2, 1}, {1, 4, 10}},
-// 			[]set.Set[tuples.Edge[int, int]]{set.New(tuples.Edges([][3]int{{1, 2, 2}, {2, 3, 2}, {3, 4, 2}}...)...)},
-// 			6,
-// 			"single cycle",
-// 		},
-// 		{
-// 			[][3]int{{1, 2, 2}, {2, 3, 3}, {3, 4, 4}, {4, 2, 1}, {1, 4, 10}},
-// 			[]set.Set[tuples.Edge[int, int]]{set.New(tuples.Edges([][3]int{{1, 2, 2}, {2, 3, 3}, {3, 4, 4}}...)...)},
-// 			9,
-// 			"graph with cycle",
-// 		},
-// 		{
-// 			[][3]int{{1, 2, 3}, {1, 3, 1}, {2, 3, 4}, {4, 2, 2}, {3, 4, 5}},
-// 			[]set.Set[tuples.Edge[int, int]]{set.New(tuples.Edges([][3]int{{1, 3, 1}, {4, 2, 2}, {3, 4, 5}}...)...)},
-// 			8,
-// 			"multiple incoming edges",
-// 		},
-// 		{
-// 			[][3]int{{1, 2, 1}, {2, 3, 2}, {4, 5, 3}},
-// 			[]set.Set[tuples.Edge[int, int]]{set.New(tuples.Edges([][3]int{{1, 2, 1}, {2, 3, 2}}...)...)},
-// 			3,
-// 			"disconnected graph",
-// 		},
-// 		{
-// 			[][3]int{{1, 2, 1}, {1, 3, 1}, {2, 4, 2}, {3, 4, 2}},
-// 			[]set.Set[tuples.Edge[int, int]]{
-// 				set.New(tuples.Edges([][3]int{{1, 2, 1}, {2, 4, 2}, {1, 3, 1}}...)...),
-// 				set.New(tuples.Edges([][3]int{{1, 3, 1}, {3, 4, 2}, {1, 2, 1}}...)...),
-// 			},
-// 			4,
-// 			"multiple solutions",
-// 		},
-// 		{
-// 			[][3]int{},
-// 			[]set.Set[tuples.Edge[int, int]]{},
-// 			0,
-// 			"single node",
-// 		},
-// 		{
-// 			[][3]int{{1, 2, 3}, {2, 2, 0}, {2, 3, 2}},
-// 			[]set.Set[tuples.Edge[int, int]]{set.New(tuples.Edges([][3]int{{1, 2, 3}, {2, 3, 2}}...)...)},
-// 			5,
-// 			"self loop",
-// 		},
-// 		{
-// 			[][3]int{{1, 2, -5}, {2, 3, -2}, {3, 1, -1}, {3, 4, 3}},
-// 			[]set.Set[tuples.Edge[int, int]]{set.New(tuples.Edges([][3]int{{1, 2, -5}, {2, 3, -2}, {3, 4, 3}}...)...)},
-// 			-4,
-// 			"negative weights",
-// 		},
-// 		{
-// 			[][3]int{{1, 2, 1}, {2, 3, 2}, {3, 4, 3}, {4, 2, 1}, {5, 4, 5}, {3, 5, 4}},
-// 			[]set.Set[tuples.Edge[int, int]]{set.New(
-// 				tuples.Edges([][3]int{{1, 2, 1}, {2, 3, 2}, {3, 4, 3}, {3, 5, 4}}...,
-// 				)...)},
-// 			10,
-// 			"overlapping cycles",
-// 		},
-// 		{
-// 			[][3]int{{1, 2, 3}, {2, 3, 5}, {3, 4, 2}, {4, 5, 7}, {5, 2, 1}, {5, 6, 10}, {6, 1, 6}, {4, 6, 8}},
-// 			[]set.Set[tuples.Edge[int, int]]{set.New(
-// 				tuples.Edges([][3]int{{1, 2, 3}, {2, 3, 5}, {3, 4, 2}, {4, 5, 7}, {4, 6, 8}}...,
-// 				)...)},
-// 			25,
-// 			"nested cycles",
-// 		},
-// 		{
-// 			[][3]int{{1, 2, 10}, {1, 3, 12}, {2, 4, 15}, {3, 5, 10}, {4, 2, 5}, {5, 3, 8}, {5, 6, 3}, {4, 6, 7}},
-// 			[]set.Set[tuples.Edge[int, int]]{
-// 				set.New(tuples.Edges([][3]int{{1, 2, 10}, {1, 3, 12}, {2, 4, 15}, {3, 5, 10}, {5, 6, 3}}...)...)},
-// 			50,
-// 			"large graph",
-// 		},
-// 		{
-// 			[][3]int{{1, 2, 1}, {2, 3, 2}, {3, 4, 3}, {4, 2, 1}, {5, 6, 4}, {6, 7, 5}, {7, 5, 3}, {1, 5, 6}},
-// 			[]set.Set[tuples.Edge[int, int]]{set.New(
-// 				tuples.Edges([][3]int{{1, 2, 1}, {2, 3, 2}, {3, 4, 3}, {1, 5, 6}, {5, 6, 4}, {6, 7, 5}}...,
-// 				)...)},
-// 			21,
-// 			"multiple cycles",
-// 		},
-// 	}
-
-// 	for i, tc := range tests {
-// 		if testOnly > 0 && testOnly != i+1 {
-// 			continue
-// 		}
-// 		g := hashgraph.New[int, int](true)
-// 		for _, edge := range tc.edges {
-// 			g.AddEdge(edge[0], edge[1], edge[2])
-// 		}
-// 		if len(tc.edges) == 0 {
-// 			g.AddVertex(1)
-// 		}
-
-// 		t.Run(tc.name, func(t *testing.T) {
-// 			mat := graph.Tarjan(g, 1)
-// 			got := set.New[tuples.Edge[int, int]]()
-// 			for e := range mat {
-// 				got.Add(e)
-// 			}
-
-// 			if got.Len() == 0 && len(tc.want) == 0 {
-// 				return
-// 			}
-
-// 			for _, s := range tc.want {
-// 				diff1 := s.Difference(got)
-// 				diff2 := got.Difference(s)
-// 				if diff1.Len() == 0 && diff2.Len() == 0 {
-// 					weightTotal := 0
-// 					for edge := range got.All() {
-// 						weightTotal += edge.Weight()
-// 					}
-// 					if weightTotal == tc.weight {
-// 						return
-// 					}
-// 				}
-// 			}
-
-// 			t.Errorf("Edmonds(%v, 1) = \n%v, \nwant \n%v", g, got, tc.want)
-// 		})
-// 	}
-// }
+func TestTarjan(t *testing.T) {
+	testOnly := 0
+	tests := []struct {
+		edges  [][3]int
+		want   []set.Set[tuples.Edge[int, int]]
+		weight int
+		name   string
+	}{
+		{
+			[][3]int{{1, 2, 1}, {1, 3, 4}, {2, 3, 2}, {2, 4, 5}, {3, 4, 1}},
+			[]set.Set[tuples.Edge[int, int]]{set.New(tuples.Edges([][3]int{{1, 2, 1}, {2, 3, 2}, {3, 4, 1}}...)...)},
+			4,
+			"simple graph",
+		},
+		{
+			[][3]int{{1, 2, 2}, {2, 3, 2}, {3, 4, 2}, {4, 2, 1}, {1, 4, 10}},
+			[]set.Set[tuples.Edge[int, int]]{set.New(tuples.Edges([][3]int{{1, 2, 2}, {2, 3, 2}, {3, 4, 2}}...)...)},
+			6,
+			"single cycle",
+		},
+		{
+			[][3]int{{1, 2, 2}, {2, 3, 3}, {3, 4, 4}, {4, 2, 1}, {1, 4, 10}},
+			[]set.Set[tuples.Edge[int, int]]{set.New(tuples.Edges([][3]int{{1, 2, 2}, {2, 3, 3}, {3, 4, 4}}...)...)},
+			9,
+			"graph with cycle",
+		},
+		{
+			[][3]int{{1, 2, 3}, {1, 3, 1}, {2, 3, 4}, {4, 2, 2}, {3, 4, 5}},
+			[]set.Set[tuples.Edge[int, int]]{set.New(tuples.Edges([][3]int{{1, 3, 1}, {4, 2, 2}, {3, 4, 5}}...)...)},
+			8,
+			"multiple incoming edges",
+		},
+		{
+			[][3]int{{1, 2, 1}, {2, 3, 2}, {4, 5, 3}},
+			nil,
+			0,
+			"disconnected graph",
+		},
+		{
+			[][3]int{{1, 2, 1}, {1, 3, 1}, {2, 4, 2}, {3, 4, 2}},
+			[]set.Set[tuples.Edge[int, int]]{
+				set.New(tuples.Edges([][3]int{{1, 2, 1}, {2, 4, 2}, {1, 3, 1}}...)...),
+				set.New(tuples.Edges([][3]int{{1, 3, 1}, {3, 4, 2}, {1, 2, 1}}...)...),
+			},
+			4,
+			"multiple solutions",
+		},
+		{
+			[][3]int{},
+			[]set.Set[tuples.Edge[int, int]]{},
+			0,
+			"single node",
+		},
+		{
+			[][3]int{{1, 2, 3}, {2, 2, 0}, {2, 3, 2}},
+			[]set.Set[tuples.Edge[int, int]]{set.New(tuples.Edges([][3]int{{1, 2, 3}, {2, 3, 2}}...)...)},
+			5,
+			"self loop",
+		},
+		{
+			[][3]int{{1, 2, -5}, {2, 3, -2}, {3, 1, -1}, {3, 4, 3}},
+			[]set.Set[tuples.Edge[int, int]]{set.New(tuples.Edges([][3]int{{1, 2, -5}, {2, 3, -2}, {3, 4, 3}}...)...)},
+			-4,
+			"negative weights",
+		},
+		{
+			[][3]int{{1, 2, 1}, {2, 3, 2}, {3, 4, 3}, {4, 2, 1}, {5, 4, 5}, {3, 5, 4}},
+			[]set.Set[tuples.Edge[int, int]]{set.New(
+				tuples.Edges([][3]int{{1, 2, 1}, {2, 3, 2}, {3, 4, 3}, {3, 5, 4}}...,
+				)...)},
+			10,
+			"overlapping cycles",
+		},
+		{
+			[][3]int{{1, 2, 3}, {2, 3, 5}, {3, 4, 2}, {4, 5, 7}, {5, 2, 1}, {5, 6, 10}, {6, 1, 6}, {4, 6, 8}},
+			[]set.Set[tuples.Edge[int, int]]{set.New(
+				tuples.Edges([][3]int{{1, 2, 3}, {2, 3, 5}, {3, 4, 2}, {4, 5, 7}, {4, 6, 8}}...,
+				)...)},
+			25,
+			"nested cycles",
+		},
+		{
+			[][3]int{{1, 2, 10}, {1, 3, 12}, {2, 4, 15}, {3, 5, 10}, {4, 2, 5}, {5, 3, 8}, {5, 6, 3}, {4, 6, 7}},
+			[]set.Set[tuples.Edge[int, int]]{
+				set.New(tuples.Edges([][3]int{{1, 2, 10}, {1, 3, 12}, {2, 4, 15}, {3, 5, 10}, {5, 6, 3}}...)...)},
+			50,
+			"large graph",
+		},
+		{
+			[][3]int{{1, 2, 1}, {2, 3, 2}, {3, 4, 3}, {4, 2, 1}, {5, 6, 4}, {6, 7, 5}, {7, 5, 3}, {1, 5, 6}},
+			[]set.Set[tuples.Edge[int, int]]{set.New(
+				tuples.Edges([][3]int{{1, 2, 1}, {2, 3, 2}, {3, 4, 3}, {1, 5, 6}, {5, 6, 4}, {6, 7, 5}}...,
+				)...)},
+			21,
+			"multiple cycles",
+		},
+	}
+
+	for i, tc := range tests {
+		if testOnly > 0 && testOnly != i+1 {
+			continue
+		}
+		g := hashgraph.New[int, int](true)
+		for _, edge := range tc.edges {
+			g.AddEdge(edge[0], edge[1], edge[2])
+		}
+		if len(tc.edges) == 0 {
+			g.AddVertex(1)
+		}
+
+		t.Run(tc.name, func(t *testing.T) {
+			mat, err := graph.Tarjan(g, 1)
+			if tc.want == nil {
+				if !errors.As(err, &graph.UnreachableError[int]{}) || mat != nil {
+					t.Errorf("Tarjan(%v, 1) = %v, %v, want nil, UnreachableError", g, mat, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Tarjan(%v, 1) error = %v, want nil", g, err)
+			}
+			got := set.New[tuples.Edge[int, int]]()
+			for e := range mat {
+				got.Add(e)
+			}
+
+			if got.Len() == 0 && len(tc.want) == 0 {
+				return
+			}
+
+			for _, s := range tc.want {
+				diff1 := s.Difference(got)
+				diff2 := got.Difference(s)
+				if diff1.Len() == 0 && diff2.Len() == 0 {
+					weightTotal := 0
+					for edge := range got.All() {
+						weightTotal += edge.Weight()
+					}
+					if weightTotal == tc.weight {
+						return
+					}
+				}
+			}
+
+			t.Errorf("Tarjan(%v, 1) = \n%v, \nwant \n%v", g, got, tc.want)
+		})
+	}
+}
+
+func TestGridHeuristics(t *testing.T) {
+	end := tuples.NewCell(1, 5)
+	src := tuples.NewCell(4, 1)
+
+	if got := graph.ManhattanHeuristic(end)(src); got != 7 {
+		t.Errorf("ManhattanHeuristic() = %v, want 7", got)
+	}
+	if got := graph.ChebyshevHeuristic(end)(src); got != 4 {
+		t.Errorf("ChebyshevHeuristic() = %v, want 4", got)
+	}
+	if got, want := graph.OctileHeuristic(end)(src), 1+3*math.Sqrt2; math.Abs(got-want) > 1e-9 {
+		t.Errorf("OctileHeuristic() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,27 @@
+package graph
+
+import (
+	"iter"
+
+	"github.com/elordeiro/goext/constraints"
+)
+
+// ImplicitGraph is a Traversable graph whose edges are generated on demand by a
+// function that yields the neighbors of a vertex along with the weights of the edges.
+// It allows searching state spaces that are too large, or even infinite, to be built
+// up front, such as puzzles and game trees, with the traversal algorithms of this
+// package. Searches of infinite graphs should be bounded with a BaseCaseOption,
+// MaxExpandedOption or MaxDepthOption.
+type ImplicitGraph[V comparable, N constraints.Number] func(V) iter.Seq2[V, N]
+
+// NewImplicitGraph returns an ImplicitGraph whose neighbors are generated by the
+// function neighbors.
+func NewImplicitGraph[V comparable, N constraints.Number](neighbors func(V) iter.Seq2[V, N]) ImplicitGraph[V, N] {
+	return ImplicitGraph[V, N](neighbors)
+}
+
+// Neighbors returns an iter.Seq2[V, N] over the neighbors of the vertex v along with
+// the weights of the edges.
+func (g ImplicitGraph[V, N]) Neighbors(v V) iter.Seq2[V, N] {
+	return g(v)
+}
@@ -0,0 +1,56 @@
+package graph_test
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"testing"
+
+	"github.com/elordeiro/goext/containers/graph"
+	"github.com/elordeiro/goext/seqs"
+)
+
+// jumps is the infinite graph over the integers where every vertex n has an edge to
+// n+1 of weight 1 and an edge to 2n of weight 3.
+var jumps = graph.NewImplicitGraph(func(n int) iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		if yield(n+1, 1) {
+			yield(2*n, 3)
+		}
+	}
+})
+
+func TestImplicitGraph(t *testing.T) {
+	goal := graph.BaseCaseOption(func(n int) bool { return n == 24 })
+
+	// 1 -> 2 -> 3 -> 6 -> 12 -> 24
+	if got := seqs.Len(graph.BFS(jumps, 1, goal)); got != 5 {
+		t.Errorf("len(BFS()) = %v, want 5", got)
+	}
+
+	// 1 -> 2 -> 3 -> 6 -> 12 -> 24 costs 1 + 1 + 3 + 3 + 3 = 11, which beats walking
+	cost := 0
+	for e := range graph.Dijkstra(jumps, 1, goal) {
+		cost += e.Weight()
+	}
+	if cost != 11 {
+		t.Errorf("Dijkstra() cost = %v, want 11", cost)
+	}
+
+	p := graph.AStarPath(jumps, 1, func(int) int { return 0 }, goal)
+	if !p.Found || p.Cost != 11 {
+		t.Errorf("AStarPath() = %+v, want a path of cost 11", p)
+	}
+
+	tree := graph.DijkstraAll(jumps, 1, graph.BaseCaseOption(func(n int) bool { return n >= 100 }))
+	if !tree.HasPathTo(24) || tree.Dist(24) != 11 {
+		t.Errorf("DijkstraAll().Dist(24) = %v, want 11", tree.Dist(24))
+	}
+
+	// an unreachable goal in an infinite graph needs a budget
+	never := graph.BaseCaseOption(func(n int) bool { return n < 0 })
+	_, err := graph.BFSContext(context.Background(), jumps, 1, never, graph.MaxExpandedOption[int](1000))
+	if !errors.Is(err, graph.ErrBudgetExceeded) {
+		t.Errorf("BFSContext() error = %v, want %v", err, graph.ErrBudgetExceeded)
+	}
+}
@@ -0,0 +1,110 @@
+package graph
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/pq"
+	"github.com/elordeiro/goext/containers/set"
+	"github.com/elordeiro/goext/containers/tuples"
+)
+
+// KShortestPaths returns up to k of the shortest loopless paths from the vertex src to
+// the vertex dst in the graph g, from the shortest to the longest. The algorithm
+// returns an iter.Seq[iter.Seq[tuples.Edge[V, N]]] where every element represents a
+// path. The paths are computed lazily, one at a time, as the sequence is iterated.
+// Edge weights must not be negative.
+// The algorithm can be configured with the following options:
+//
+//   - VertexFilterOption: return true if the edge from src to dst should be considered,
+//     false otherwise.
+//
+// The algorithm used is Yen's algorithm, which runs a Dijkstra search from every
+// vertex of the previous path to find the next one.
+func KShortestPaths[V comparable, N constraints.Number](
+	g Traversable[V, N],
+	src, dst V,
+	k int,
+	options ...option[V],
+) iter.Seq[iter.Seq[tuples.Edge[V, N]]] {
+	opts := setOptions(options...)
+
+	// shortest returns the shortest path from start to dst that avoids the vertices
+	// in root and the edges in removed, along with its cost
+	shortest := func(start V, root set.Set[V], removed set.Set[[2]V]) ([]tuples.Edge[V, N], N, bool) {
+		spurOpts := setOptions(
+			BaseCaseOption(func(v V) bool { return v == dst }),
+			VertexFilterOption(func(u, v V) bool {
+				return !root.Contains(v) && !removed.Contains([2]V{u, v}) && opts.vertexFilter(u, v)
+			}),
+		)
+		dist, prev, _, _ := dijkstraAll(g, start, spurOpts)
+		d, ok := dist[dst]
+		if !ok {
+			return nil, 0, false
+		}
+		return slices.Collect(rebuildPath(dst, start, prev)), d, true
+	}
+
+	return func(yield func(iter.Seq[tuples.Edge[V, N]]) bool) {
+		if k <= 0 {
+			return
+		}
+		first, _, ok := shortest(src, set.New[V](), set.New[[2]V]())
+		if !ok || !yield(slices.Values(first)) {
+			return
+		}
+
+		type candidate struct {
+			path []tuples.Edge[V, N]
+			cost N
+		}
+		candidates := pq.NewPQFunc(func(c1, c2 candidate) bool {
+			return c1.cost < c2.cost
+		})
+		found := [][]tuples.Edge[V, N]{first}
+		seen := [][]tuples.Edge[V, N]{first} // every path found or waiting as a candidate
+
+		for len(found) < k {
+			last := found[len(found)-1]
+			for i, e := range last {
+				// branch off the previous path at its i-th vertex, avoiding the edges
+				// taken there by every path found with the same root
+				rootPath := last[:i]
+				removed := set.New[[2]V]()
+				for _, p := range found {
+					if len(p) > i && slices.Equal(p[:i], rootPath) {
+						removed.Add([2]V{p[i].Src(), p[i].Dst()})
+					}
+				}
+				root := set.New[V]()
+				var rootCost N
+				for _, r := range rootPath {
+					root.Add(r.Src())
+					rootCost += r.Weight()
+				}
+
+				spur, spurCost, ok := shortest(e.Src(), root, removed)
+				if !ok {
+					continue
+				}
+				path := append(slices.Clone(rootPath), spur...)
+				if slices.ContainsFunc(seen, func(p []tuples.Edge[V, N]) bool { return slices.Equal(p, path) }) {
+					continue
+				}
+				seen = append(seen, path)
+				candidates.Push(candidate{path, rootCost + spurCost})
+			}
+
+			if candidates.IsEmpty() {
+				return
+			}
+			next := candidates.Pop().path
+			found = append(found, next)
+			if !yield(slices.Values(next)) {
+				return
+			}
+		}
+	}
+}
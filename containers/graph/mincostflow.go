@@ -0,0 +1,268 @@
+package graph
+
+import (
+	"iter"
+	"maps"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/pq"
+	"github.com/elordeiro/goext/containers/tuples"
+	extMath "github.com/elordeiro/goext/math"
+)
+
+// CostGraph is a Graph whose edges also carry a cost, such as a flow network where
+// the weight of an edge is its capacity and the cost is the price of sending one unit
+// of flow through it. CostEdges yields the same edges as Edges along with their costs.
+type CostGraph[V comparable, N constraints.Number] interface {
+	Graph[V, N]
+	CostEdges() iter.Seq[tuples.CostEdge[V, N]]
+}
+
+// costGraph is the CostGraph returned by NewCostGraph
+type costGraph[V comparable, N constraints.Number] struct {
+	isDirected bool
+	vertices   []V
+	edges      []tuples.CostEdge[V, N]
+	adj        map[V][]tuples.Pair[V, N]
+}
+
+// NewCostGraph returns a CostGraph with the specified directedness and the edges,
+// along with the vertices they connect. Parallel edges are kept as separate edges.
+func NewCostGraph[V comparable, N constraints.Number](isDirected bool, edges ...tuples.CostEdge[V, N]) CostGraph[V, N] {
+	g := &costGraph[V, N]{isDirected: isDirected, edges: edges, adj: map[V][]tuples.Pair[V, N]{}}
+	for _, e := range edges {
+		for _, v := range []V{e.Src(), e.Dst()} {
+			if _, ok := g.adj[v]; !ok {
+				g.vertices = append(g.vertices, v)
+				g.adj[v] = nil
+			}
+		}
+		g.adj[e.Src()] = append(g.adj[e.Src()], tuples.NewPair(e.Dst(), e.Weight()))
+		if !isDirected && e.Src() != e.Dst() {
+			g.adj[e.Dst()] = append(g.adj[e.Dst()], tuples.NewPair(e.Src(), e.Weight()))
+		}
+	}
+	return g
+}
+
+// IsDirected returns true if the graph is directed and false otherwise.
+func (g *costGraph[V, N]) IsDirected() bool {
+	return g.isDirected
+}
+
+// VertexCount returns the number of vertices in the graph.
+func (g *costGraph[V, N]) VertexCount() int {
+	return len(g.vertices)
+}
+
+// Vertices returns an iter.Seq[V] over the vertices in the order they were added.
+func (g *costGraph[V, N]) Vertices() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range g.vertices {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Edges returns an iter.Seq[tuples.Edge[V, N]] over the edges without their costs.
+func (g *costGraph[V, N]) Edges() iter.Seq[tuples.Edge[V, N]] {
+	return func(yield func(tuples.Edge[V, N]) bool) {
+		for _, e := range g.edges {
+			if !yield(e.Edge) {
+				return
+			}
+		}
+	}
+}
+
+// CostEdges returns an iter.Seq[tuples.CostEdge[V, N]] over the edges along with their
+// costs.
+func (g *costGraph[V, N]) CostEdges() iter.Seq[tuples.CostEdge[V, N]] {
+	return func(yield func(tuples.CostEdge[V, N]) bool) {
+		for _, e := range g.edges {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbors returns an iter.Seq2[V, N] over the neighbors of a vertex along with the
+// weights of the edges. Parallel edges yield the same neighbor more than once.
+func (g *costGraph[V, N]) Neighbors(vertex V) iter.Seq2[V, N] {
+	return func(yield func(V, N) bool) {
+		for _, p := range g.adj[vertex] {
+			if !yield(p.Left(), p.Right()) {
+				return
+			}
+		}
+	}
+}
+
+// MinCostFlow sends flow from the vertex source to the vertex sink in the graph g,
+// where the weight of every edge is its capacity and its cost is the cost of sending
+// one unit of flow through it. It sends as much flow as possible, up to the optional
+// limit, and among all the flows of that value it finds the one with the minimum
+// total cost. It returns the value of the flow, its total cost and an
+// iter.Seq[tuples.CostEdge[V, N]] over the edges that carry flow, where the weight of
+// every edge is the amount of flow going through it.
+// In undirected graphs every edge can carry flow in both directions independently.
+// Costs may be negative, but if a cycle with a negative total cost can be reached
+// from the source, a NegativeCycleError carrying the cycle, weighted by cost, is
+// returned.
+// The algorithm used is successive shortest paths, where Dijkstra is run on costs
+// made non-negative by vertex potentials.
+func MinCostFlow[V comparable, N constraints.Number](
+	g CostGraph[V, N],
+	source, sink V,
+	limit ...N,
+) (N, N, iter.Seq[tuples.CostEdge[V, N]], error) {
+	nw := newNetwork(g, g.CostEdges())
+	s, ok1 := nw.index[source]
+	t, ok2 := nw.index[sink]
+	if !ok1 || !ok2 || s == t {
+		return 0, 0, func(yield func(tuples.CostEdge[V, N]) bool) {}, nil
+	}
+
+	maxFlow := extMath.Inf[N]()
+	if len(limit) > 0 {
+		maxFlow = limit[0]
+	}
+
+	flow, total, err := nw.successiveShortestPaths(s, t, maxFlow)
+	if err != nil {
+		return 0, 0, func(yield func(tuples.CostEdge[V, N]) bool) {}, err
+	}
+
+	return flow, total, func(yield func(tuples.CostEdge[V, N]) bool) {
+		for a := 0; a < len(nw.to); a += 2 {
+			if f := nw.flow(a); f > 0 {
+				u, v := nw.vertices[nw.from[a]], nw.vertices[nw.to[a]]
+				if !yield(tuples.NewCostEdge(u, v, f, nw.cost[a])) {
+					return
+				}
+			}
+		}
+	}, nil
+}
+
+// successiveShortestPaths sends up to limit units of flow from s to t, always
+// augmenting along the cheapest path in the residual network, and returns the
+// value and the total cost of the flow.
+func (nw *network[V, N]) successiveShortestPaths(s, t int, limit N) (N, N, error) {
+	n := len(nw.vertices)
+	pot, err := nw.potentials(s)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var flow, total N
+	for flow < limit {
+		// Dijkstra on the reduced costs, which are never negative. The loop is not
+		// shared with dijkstraAll, since an arc and the reverse of a parallel arc
+		// may join the same two vertices, so the augmenting path has to be recorded
+		// by arc rather than by predecessor vertex.
+		dist := make([]N, n)
+		reached := make([]bool, n)
+		done := make([]bool, n)
+		prevArc := make([]int, n)
+
+		reached[s] = true
+		pq := pq.NewPQFunc(func(p1, p2 tuples.Pair[int, N]) bool {
+			return p1.Right() < p2.Right()
+		}, tuples.NewPair(s, N(0)))
+
+		for !pq.IsEmpty() {
+			u := pq.Pop().Left()
+			if done[u] {
+				continue
+			}
+			done[u] = true
+			for _, a := range nw.adj[u] {
+				v := nw.to[a]
+				if nw.res[a] <= 0 || done[v] {
+					continue
+				}
+				d := dist[u] + nw.cost[a] + pot[u] - pot[v]
+				if !reached[v] || d < dist[v] {
+					reached[v] = true
+					dist[v] = d
+					prevArc[v] = a
+					pq.Push(tuples.NewPair(v, d))
+				}
+			}
+		}
+
+		if !reached[t] {
+			break
+		}
+		for v := range n {
+			if reached[v] {
+				pot[v] += dist[v]
+			}
+		}
+
+		push := limit - flow
+		for v := t; v != s; v = nw.from[prevArc[v]] {
+			push = min(push, nw.res[prevArc[v]])
+		}
+		for v := t; v != s; v = nw.from[prevArc[v]] {
+			a := prevArc[v]
+			nw.res[a] -= push
+			nw.res[a^1] += push
+			total += push * nw.cost[a]
+		}
+		flow += push
+	}
+
+	return flow, total, nil
+}
+
+// potentials returns the cost of the cheapest path from s to every vertex using
+// only the arcs with residual capacity, so that the reduced costs of those arcs are
+// never negative. If a negative cycle can be reached from s, a NegativeCycleError
+// is returned.
+func (nw *network[V, N]) potentials(s int) ([]N, error) {
+	n := len(nw.vertices)
+	pot := make([]N, n)
+	reached := make([]bool, n)
+	prevArc := make([]int, n)
+	for v := range prevArc {
+		prevArc[v] = -1
+	}
+	reached[s] = true
+
+	relax := func() bool {
+		changed := false
+		for a := range nw.to {
+			u, v := nw.from[a], nw.to[a]
+			if nw.res[a] <= 0 || !reached[u] {
+				continue
+			}
+			if d := pot[u] + nw.cost[a]; !reached[v] || d < pot[v] {
+				reached[v] = true
+				pot[v] = d
+				prevArc[v] = a
+				changed = true
+			}
+		}
+		return changed
+	}
+
+	for round := 0; relax(); round++ {
+		if round < n-1 {
+			continue
+		}
+		prev := map[V]tuples.Pair[V, N]{}
+		for v := range n {
+			if a := prevArc[v]; a != -1 {
+				prev[nw.vertices[v]] = tuples.NewPair(nw.vertices[nw.from[a]], nw.cost[a])
+			}
+		}
+		cycle, _ := predecessorCycle(prev, maps.Keys(prev))
+		return nil, NegativeCycleError[V, N]{Cycle: cycle}
+	}
+	return pot, nil
+}
@@ -0,0 +1,139 @@
+package graph_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/elordeiro/goext/containers/graph"
+	"github.com/elordeiro/goext/containers/tuples"
+	"github.com/elordeiro/goext/seq2s"
+	"github.com/elordeiro/goext/seqs"
+)
+
+func TestMinCostFlow(t *testing.T) {
+	tests := []struct {
+		name               string
+		edges              [][4]int
+		limit              []int
+		wantFlow, wantCost int
+	}{
+		{
+			"two routes",
+			[][4]int{{0, 1, 4, 1}, {0, 2, 2, 5}, {1, 2, 2, 1}, {1, 3, 3, 3}, {2, 3, 5, 1}},
+			nil,
+			6, 26,
+		},
+		{
+			"limited flow",
+			[][4]int{{0, 1, 4, 1}, {0, 2, 2, 5}, {1, 2, 2, 1}, {1, 3, 3, 3}, {2, 3, 5, 1}},
+			[]int{3},
+			3, 10,
+		},
+		{
+			"expensive bottleneck",
+			[][4]int{{0, 1, 1, 1}, {0, 2, 1, 1}, {1, 2, 1, 1}, {1, 3, 1, 10}, {2, 3, 1, 1}},
+			nil,
+			2, 13,
+		},
+		{
+			"negative costs",
+			[][4]int{{0, 1, 2, -2}, {0, 2, 2, 1}, {1, 3, 1, 1}, {2, 3, 2, 1}, {1, 2, 1, -1}},
+			nil,
+			3, -1,
+		},
+		{
+			"no path",
+			[][4]int{{0, 1, 1, 1}, {2, 3, 1, 1}},
+			nil,
+			0, 0,
+		},
+	}
+
+	for _, tc := range tests {
+		g := graph.NewCostGraph(true, tuples.CostEdges(tc.edges...)...)
+
+		t.Run(tc.name, func(t *testing.T) {
+			flow, total, edges, err := graph.MinCostFlow(g, 0, 3, tc.limit...)
+			if err != nil {
+				t.Fatalf("MinCostFlow(%v) error = %v, want nil", g, err)
+			}
+			if flow != tc.wantFlow || total != tc.wantCost {
+				t.Errorf("MinCostFlow(%v) = %v, %v, want %v, %v", g, flow, total, tc.wantFlow, tc.wantCost)
+			}
+
+			capacity, cost := map[[2]int]int{}, map[[2]int]int{}
+			for e := range g.CostEdges() {
+				capacity[[2]int{e.Src(), e.Dst()}] = e.Weight()
+				cost[[2]int{e.Src(), e.Dst()}] = e.Cost()
+			}
+			sum, balance := 0, map[int]int{}
+			for e := range edges {
+				arc := [2]int{e.Src(), e.Dst()}
+				if e.Weight() > capacity[arc] || e.Cost() != cost[arc] {
+					t.Errorf("MinCostFlow(%v) edge %v does not match the graph", g, e)
+				}
+				sum += e.Weight() * e.Cost()
+				balance[e.Src()] -= e.Weight()
+				balance[e.Dst()] += e.Weight()
+			}
+			if sum != total || balance[3] != flow || balance[1] != 0 || balance[2] != 0 {
+				t.Errorf("MinCostFlow(%v) edges cost %v with balance %v", g, sum, balance)
+			}
+		})
+	}
+}
+
+func TestMinCostFlowAssignment(t *testing.T) {
+	costs := [][]int{
+		{9, 2, 7, 8},
+		{6, 4, 3, 7},
+		{5, 8, 1, 8},
+		{7, 6, 9, 4},
+	}
+
+	var edges []tuples.CostEdge[string, int]
+	for i := range costs {
+		edges = append(edges,
+			tuples.NewCostEdge("s", fmt.Sprint("w", i), 1, 0),
+			tuples.NewCostEdge(fmt.Sprint("t", i), "t", 1, 0))
+		for j, c := range costs[i] {
+			edges = append(edges, tuples.NewCostEdge(fmt.Sprint("w", i), fmt.Sprint("t", j), 1, c))
+		}
+	}
+	g := graph.NewCostGraph(true, edges...)
+
+	flow, total, _, err := graph.MinCostFlow(g, "s", "t")
+	if err != nil || flow != 4 || total != 13 {
+		t.Errorf("MinCostFlow() = %v, %v, %v, want 4, 13, nil", flow, total, err)
+	}
+}
+
+func TestMinCostFlowNegativeCycle(t *testing.T) {
+	g := graph.NewCostGraph(true, tuples.CostEdges([4]int{0, 1, 1, 1}, [4]int{1, 2, 1, -3}, [4]int{2, 1, 1, 1}, [4]int{2, 3, 1, 1})...)
+
+	_, _, _, err := graph.MinCostFlow(g, 0, 3)
+	var cycleErr graph.NegativeCycleError[int, int]
+	if !errors.As(err, &cycleErr) || len(cycleErr.Cycle) != 2 {
+		t.Errorf("MinCostFlow(%v) error = %v, want NegativeCycleError", g, err)
+	}
+}
+
+func TestCostGraph(t *testing.T) {
+	g := graph.NewCostGraph(false, tuples.CostEdges([4]int{1, 2, 3, 1}, [4]int{1, 2, 4, 2}, [4]int{2, 3, 5, 1})...)
+	if got := g.VertexCount(); got != 3 {
+		t.Errorf("VertexCount() = %v, want 3", got)
+	}
+	if got := seqs.Len(g.Edges()); got != 3 {
+		t.Errorf("len(Edges()) = %v, want 3", got)
+	}
+	if got := seq2s.Len(g.Neighbors(2)); got != 3 {
+		t.Errorf("len(Neighbors(2)) = %v, want 3", got)
+	}
+
+	// both parallel edges carry flow, in either direction of the undirected edges
+	flow, total, _, err := graph.MinCostFlow(g, 3, 1)
+	if err != nil || flow != 5 || total != 5+3+2*2 {
+		t.Errorf("MinCostFlow(3, 1) = %v, %v, %v, want 5, 12, nil", flow, total, err)
+	}
+}
@@ -0,0 +1,184 @@
+package graph
+
+import (
+	"cmp"
+	"iter"
+	"runtime"
+	"slices"
+	"sync"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/pq"
+	"github.com/elordeiro/goext/containers/set"
+	"github.com/elordeiro/goext/containers/tuples"
+	"github.com/elordeiro/goext/containers/unionfind"
+	"github.com/elordeiro/goext/containers/vector"
+)
+
+// Prim returns the minimum spanning tree of the component of the undirected graph g
+// that contains the vertex start, or of the component of any vertex if start is not
+// given. The algorithm returns an iter.Seq[tuples.Edge[V, N]] that represents the
+// minimum spanning tree. Prim panics if the graph is directed.
+// The algorithm used is Prim's algorithm with a priority queue, which is a good fit
+// for dense graphs.
+func Prim[V comparable, N constraints.Number](g Graph[V, N], start ...V) iter.Seq[tuples.Edge[V, N]] {
+	if g.IsDirected() {
+		panic("graph is directed")
+	}
+	if g.VertexCount() == 0 {
+		return func(yield func(tuples.Edge[V, N]) bool) {}
+	}
+
+	root := anyVertex(g)
+	if len(start) > 0 {
+		root = start[0]
+	}
+	return prim(g, root, set.New[V]()).Values()
+}
+
+// prim returns the minimum spanning tree of the component of g that contains root.
+// Every vertex of the component is added to visited.
+func prim[V comparable, N constraints.Number](g Graph[V, N], root V, visited set.Set[V]) vector.Vector[tuples.Edge[V, N]] {
+	pq := pq.NewPQFunc(func(e1, e2 tuples.Edge[V, N]) bool {
+		return e1.Weight() < e2.Weight()
+	})
+
+	mst := vector.New[tuples.Edge[V, N]]()
+	visit := func(src V) {
+		visited.Add(src)
+		for dst, w := range g.Neighbors(src) {
+			if !visited.Contains(dst) {
+				pq.Push(tuples.NewEdge(src, dst, w))
+			}
+		}
+	}
+
+	visit(root)
+	for !pq.IsEmpty() {
+		e := pq.Pop()
+		if visited.Contains(e.Dst()) {
+			continue
+		}
+		mst.Push(e)
+		visit(e.Dst())
+	}
+	return mst
+}
+
+// MinimumSpanningForest returns the minimum spanning forest of the undirected graph g,
+// with one minimum spanning tree per connected component. The algorithm returns an
+// iter.Seq2[set.Set[V], iter.Seq[tuples.Edge[V, N]]] that yields the vertices of
+// every component along with the edges of its tree. Components with a single vertex
+// yield an empty tree. MinimumSpanningForest panics if the graph is directed.
+func MinimumSpanningForest[V comparable, N constraints.Number](
+	g Graph[V, N],
+) iter.Seq2[set.Set[V], iter.Seq[tuples.Edge[V, N]]] {
+	if g.IsDirected() {
+		panic("graph is directed")
+	}
+
+	return func(yield func(set.Set[V], iter.Seq[tuples.Edge[V, N]]) bool) {
+		visited := set.New[V]()
+		for v := range g.Vertices() {
+			if visited.Contains(v) {
+				continue
+			}
+			component := set.New[V]()
+			tree := prim(g, v, component)
+			for u := range component {
+				visited.Add(u)
+			}
+			if !yield(component, tree.Values()) {
+				return
+			}
+		}
+	}
+}
+
+// Boruvka returns the minimum spanning forest of the undirected graph g. The algorithm
+// returns an iter.Seq[tuples.Edge[V, N]] that represents the minimum spanning forest.
+// The cheapest edge leaving every component is searched for in parallel by the given
+// number of workers, which defaults to runtime.GOMAXPROCS(0).
+// Boruvka panics if the graph is directed.
+// The algorithm used is Borůvka's algorithm.
+func Boruvka[V comparable, N constraints.Number](g Graph[V, N], workers ...int) iter.Seq[tuples.Edge[V, N]] {
+	if g.IsDirected() {
+		panic("graph is directed")
+	}
+	nWorkers := runtime.GOMAXPROCS(0)
+	if len(workers) > 0 && workers[0] > 0 {
+		nWorkers = workers[0]
+	}
+
+	edges := slices.Collect(g.Edges())
+	uf := unionfind.New[V]()
+	for v := range g.Vertices() {
+		uf.MakeSet(v)
+	}
+
+	// edges are compared by weight and then by position to break ties, which keeps
+	// the cheapest edges of different components from closing a cycle
+	less := func(i, j int) bool {
+		if c := cmp.Compare(edges[i].Weight(), edges[j].Weight()); c != 0 {
+			return c < 0
+		}
+		return i < j
+	}
+
+	mst := vector.New[tuples.Edge[V, N]]()
+	for {
+		// the union find is not safe for concurrent use, so the components are
+		// resolved before the workers start
+		src := make([]V, len(edges))
+		dst := make([]V, len(edges))
+		for i, e := range edges {
+			src[i], dst[i] = uf.Find(e.Src()), uf.Find(e.Dst())
+		}
+
+		results := make([]map[V]int, nWorkers)
+		chunk := (len(edges) + nWorkers - 1) / nWorkers
+		var wg sync.WaitGroup
+		for w := range nWorkers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				cheapest := map[V]int{}
+				update := func(c V, i int) {
+					if j, ok := cheapest[c]; !ok || less(i, j) {
+						cheapest[c] = i
+					}
+				}
+				for i := w * chunk; i < min((w+1)*chunk, len(edges)); i++ {
+					if src[i] != dst[i] {
+						update(src[i], i)
+						update(dst[i], i)
+					}
+				}
+				results[w] = cheapest
+			}()
+		}
+		wg.Wait()
+
+		cheapest := map[V]int{}
+		for _, result := range results {
+			for c, i := range result {
+				if j, ok := cheapest[c]; !ok || less(i, j) {
+					cheapest[c] = i
+				}
+			}
+		}
+		if len(cheapest) == 0 {
+			break
+		}
+
+		for _, i := range cheapest {
+			e := edges[i]
+			if !uf.Connected(e.Src(), e.Dst()) {
+				uf.Union(e.Src(), e.Dst())
+				mst.Push(e)
+			}
+		}
+	}
+
+	return mst.Values()
+}
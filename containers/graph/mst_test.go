@@ -0,0 +1,138 @@
+package graph_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/elordeiro/goext/containers/graph"
+	"github.com/elordeiro/goext/containers/hashgraph"
+	"github.com/elordeiro/goext/containers/set"
+	"github.com/elordeiro/goext/containers/tuples"
+	"github.com/elordeiro/goext/seqs"
+	"github.com/elordeiro/goext/seqs/transform"
+)
+
+func mstGraph() *hashgraph.HashGraph[string, int] {
+	g := hashgraph.New[string, int](false)
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("a", "d", 4)
+	g.AddEdge("a", "e", 3)
+	g.AddEdge("b", "d", 4)
+	g.AddEdge("b", "e", 2)
+	g.AddEdge("c", "e", 4)
+	g.AddEdge("c", "f", 5)
+	g.AddEdge("d", "e", 4)
+	g.AddEdge("e", "f", 7)
+	return g
+}
+
+func TestPrim(t *testing.T) {
+	g := mstGraph()
+	mst := graph.Prim(g, "c")
+
+	got := transform.Reduce(mst, func(acc int, e tuples.Edge[string, int]) int { return acc + e.Weight() })
+	if got != 16 {
+		t.Errorf("Weight() = %v, want 16", got)
+	}
+	if n := seqs.Len(mst); n != g.VertexCount()-1 {
+		t.Errorf("len(Prim()) = %v, want %v", n, g.VertexCount()-1)
+	}
+}
+
+func TestBoruvka(t *testing.T) {
+	g := mstGraph()
+	for _, workers := range []int{1, 2, 8} {
+		mst := graph.Boruvka(g, workers)
+		got := transform.Reduce(mst, func(acc int, e tuples.Edge[string, int]) int { return acc + e.Weight() })
+		if got != 16 {
+			t.Errorf("Boruvka(g, %d) weight = %v, want 16", workers, got)
+		}
+		if n := seqs.Len(mst); n != g.VertexCount()-1 {
+			t.Errorf("len(Boruvka(g, %d)) = %v, want %v", workers, n, g.VertexCount()-1)
+		}
+	}
+}
+
+func TestMSTAgree(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	g := hashgraph.New[int, float64](false)
+	for range 400 {
+		g.AddEdge(r.IntN(60), r.IntN(60), float64(r.IntN(10))+r.Float64())
+	}
+
+	weight := func(seq func(func(tuples.Edge[int, float64]) bool)) float64 {
+		return transform.Reduce(seq, func(acc float64, e tuples.Edge[int, float64]) float64 { return acc + e.Weight() })
+	}
+
+	kruskal := weight(graph.Kruskal(g))
+	boruvka := weight(graph.Boruvka(g))
+	forest := 0.0
+	for _, tree := range graph.MinimumSpanningForest(g) {
+		forest += weight(tree)
+	}
+
+	const eps = 1e-9
+	if diff := kruskal - boruvka; diff > eps || diff < -eps {
+		t.Errorf("Kruskal() = %v, Boruvka() = %v, want equal weights", kruskal, boruvka)
+	}
+	if diff := kruskal - forest; diff > eps || diff < -eps {
+		t.Errorf("Kruskal() = %v, MinimumSpanningForest() = %v, want equal weights", kruskal, forest)
+	}
+}
+
+func TestKruskalFractionalWeights(t *testing.T) {
+	g := hashgraph.New[int, float64](false)
+	g.AddEdge(1, 2, 0.5)
+	g.AddEdge(2, 3, 0.25)
+	g.AddEdge(1, 3, 0.75)
+
+	got := transform.Reduce(graph.Kruskal(g), func(acc float64, e tuples.Edge[int, float64]) float64 { return acc + e.Weight() })
+	if got != 0.75 {
+		t.Errorf("Kruskal() weight = %v, want 0.75", got)
+	}
+}
+
+func TestMinimumSpanningForest(t *testing.T) {
+	g := hashgraph.New[int, int](false)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 3, 2)
+	g.AddEdge(1, 3, 3)
+	g.AddEdge(4, 5, 7)
+	g.AddVertex(6)
+
+	want := map[int]int{1: 3, 4: 7, 6: 0}
+	components := []set.Set[int]{set.New(1, 2, 3), set.New(4, 5), set.New(6)}
+
+	n := 0
+	for component, tree := range graph.MinimumSpanningForest(g) {
+		n++
+		found := false
+		for _, c := range components {
+			if !c.Equal(component) {
+				continue
+			}
+			found = true
+			var rep int
+			for v := range c {
+				if _, ok := want[v]; ok {
+					rep = v
+				}
+			}
+			got := transform.Reduce(tree, func(acc int, e tuples.Edge[int, int]) int { return acc + e.Weight() })
+			if got != want[rep] {
+				t.Errorf("MinimumSpanningForest() tree of %v weighs %v, want %v", component, got, want[rep])
+			}
+			for e := range tree {
+				if !component.Contains(e.Src()) || !component.Contains(e.Dst()) {
+					t.Errorf("MinimumSpanningForest() tree of %v has edge %v", component, e)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("MinimumSpanningForest() component = %v, want one of %v", component, components)
+		}
+	}
+	if n != len(components) {
+		t.Errorf("MinimumSpanningForest() returned %v trees, want %v", n, len(components))
+	}
+}
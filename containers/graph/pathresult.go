@@ -0,0 +1,146 @@
+package graph
+
+import (
+	"context"
+	"iter"
+	"slices"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/tuples"
+)
+
+// PathResult holds the outcome of a path search. Unlike the bare sequence of edges
+// returned by Path, ShortesPath, Dijkstra and AStar, it tells a failed search apart
+// from an empty path, which is found when the start vertex already is the goal.
+// If the search was interrupted before reaching its goal, the path leads to the vertex
+// it was about to expand instead.
+type PathResult[V comparable, N constraints.Number] struct {
+	// Found reports whether the search reached its goal.
+	Found bool
+	// Cost is the sum of the weights of the edges of the path.
+	Cost N
+	// Explored is the number of vertices the search expanded, whether it reached
+	// its goal or not.
+	Explored int
+
+	start   V
+	edges   []tuples.Edge[V, N]
+	partial bool
+}
+
+// Edges returns an iter.Seq[tuples.Edge[V, N]] over the edges of the path. If no path
+// was found and the search was not interrupted, an empty sequence is returned.
+func (p PathResult[V, N]) Edges() iter.Seq[tuples.Edge[V, N]] {
+	return slices.Values(p.edges)
+}
+
+// Vertices returns an iter.Seq[V] over the vertices of the path, starting with the
+// start vertex. If no path was found and the search was not interrupted, an empty
+// sequence is returned.
+func (p PathResult[V, N]) Vertices() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		if !(p.Found || p.partial) || !yield(p.start) {
+			return
+		}
+		for _, e := range p.edges {
+			if !yield(e.Dst()) {
+				return
+			}
+		}
+	}
+}
+
+// Len returns the number of edges of the path.
+func (p PathResult[V, N]) Len() int {
+	return len(p.edges)
+}
+
+// FindPath works like Path but returns a PathResult.
+// The algorithm used is depth-first search.
+func FindPath[V comparable, N constraints.Number](g Traversable[V, N], src, dst V) PathResult[V, N] {
+	baseCase := BaseCaseOption(func(src V) bool { return src == dst })
+	result, _ := search(context.Background(), src, func(stats option[V]) iter.Seq[tuples.Edge[V, N]] {
+		return DFS(g, src, baseCase, stats)
+	})
+	return result
+}
+
+// FindShortestPath works like ShortesPath but returns a PathResult.
+// The algorithm used is breadth-first search.
+func FindShortestPath[V comparable, N constraints.Number](g Traversable[V, N], src, dst V) PathResult[V, N] {
+	baseCase := BaseCaseOption(func(src V) bool { return src == dst })
+	result, _ := search(context.Background(), src, func(stats option[V]) iter.Seq[tuples.Edge[V, N]] {
+		return BFS(g, src, baseCase, stats)
+	})
+	return result
+}
+
+// DijkstraPath works like Dijkstra but returns a PathResult. The goal of the search
+// must be given by a BaseCaseOption or an EarlyReturnOption, otherwise the result is
+// never found.
+func DijkstraPath[V comparable, N constraints.Number](
+	g Traversable[V, N],
+	start V,
+	options ...option[V],
+) PathResult[V, N] {
+	result, _ := search(context.Background(), start, func(stats option[V]) iter.Seq[tuples.Edge[V, N]] {
+		return Dijkstra(g, start, append(slices.Clip(options), stats)...)
+	})
+	return result
+}
+
+// AStarPath works like AStar but returns a PathResult. The goal of the search must be
+// given by a BaseCaseOption or an EarlyReturnOption, otherwise the result is never
+// found.
+func AStarPath[V comparable, N constraints.Number](
+	g Traversable[V, N],
+	start V,
+	h heuristic[V, N],
+	options ...option[V],
+) PathResult[V, N] {
+	result, _ := search(context.Background(), start, func(stats option[V]) iter.Seq[tuples.Edge[V, N]] {
+		return AStar(g, start, h, append(slices.Clip(options), stats)...)
+	})
+	return result
+}
+
+// search runs the search given by run with an extra option that counts the vertices
+// it expands, records whether it stopped at its goal and stops it once ctx is done,
+// and returns the PathResult along with the reason the search was interrupted, if any.
+// The option must be applied last so that it wraps the options of the caller.
+func search[V comparable, N constraints.Number](
+	ctx context.Context,
+	start V,
+	run func(stats option[V]) iter.Seq[tuples.Edge[V, N]],
+) (PathResult[V, N], error) {
+	result := PathResult[V, N]{start: start}
+	var opts *pathFindOptions[V]
+	stats := func(o *pathFindOptions[V]) {
+		opts = o
+		o.ctx = ctx
+		baseCase, earlyReturn := o.baseCase, o.earlyReturn
+		o.baseCase = func(v V) bool {
+			result.Explored++
+			result.Found = baseCase(v)
+			return result.Found
+		}
+		o.earlyReturn = func(src, dst V) bool {
+			result.Found = earlyReturn(src, dst)
+			return result.Found
+		}
+	}
+
+	edges := slices.Collect(run(stats))
+	err := opts.err
+	if err == nil && !result.Found && opts.pruned {
+		err = ErrBudgetExceeded
+	}
+	if result.Found || opts.err != nil {
+		result.edges = edges
+		result.partial = !result.Found
+		for _, e := range edges {
+			result.Cost += e.Weight()
+		}
+	}
+	return result, err
+}
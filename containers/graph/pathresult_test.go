@@ -0,0 +1,74 @@
+package graph_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/elordeiro/goext/containers/graph"
+	"github.com/elordeiro/goext/containers/hashgraph"
+	"github.com/elordeiro/goext/containers/tuples"
+)
+
+func TestPathResult(t *testing.T) {
+	g := hashgraph.New[int, int](true)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 3, 1)
+	g.AddEdge(1, 3, 5)
+	g.AddVertex(4)
+	zero := func(int) int { return 0 }
+
+	tests := []struct {
+		name   string
+		search func(src, dst int) graph.PathResult[int, int]
+	}{
+		{"FindPath", func(src, dst int) graph.PathResult[int, int] {
+			return graph.FindPath(g, src, dst)
+		}},
+		{"FindShortestPath", func(src, dst int) graph.PathResult[int, int] {
+			return graph.FindShortestPath(g, src, dst)
+		}},
+		{"DijkstraPath", func(src, dst int) graph.PathResult[int, int] {
+			return graph.DijkstraPath(g, src, graph.BaseCaseOption(func(v int) bool { return v == dst }))
+		}},
+		{"AStarPath", func(src, dst int) graph.PathResult[int, int] {
+			return graph.AStarPath(g, src, zero, graph.BaseCaseOption(func(v int) bool { return v == dst }))
+		}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := tc.search(1, 3)
+			if !p.Found || p.Explored == 0 {
+				t.Errorf("%s(1, 3) = %+v, want a path", tc.name, p)
+			}
+			vs := slices.Collect(p.Vertices())
+			if vs[0] != 1 || vs[len(vs)-1] != 3 || len(vs) != p.Len()+1 {
+				t.Errorf("%s(1, 3).Vertices() = %v", tc.name, vs)
+			}
+			cost := 0
+			for e := range p.Edges() {
+				cost += e.Weight()
+			}
+			if cost != p.Cost {
+				t.Errorf("%s(1, 3).Cost = %v, want %v", tc.name, p.Cost, cost)
+			}
+
+			// an empty path is found from a vertex to itself
+			p = tc.search(1, 1)
+			if !p.Found || p.Len() != 0 || p.Cost != 0 || !slices.Equal(slices.Collect(p.Vertices()), []int{1}) {
+				t.Errorf("%s(1, 1) = %+v, want an empty path", tc.name, p)
+			}
+
+			p = tc.search(1, 4)
+			if p.Found || p.Len() != 0 || len(slices.Collect(p.Vertices())) != 0 {
+				t.Errorf("%s(1, 4) = %+v, want no path", tc.name, p)
+			}
+		})
+	}
+
+	p := graph.DijkstraPath(g, 1, graph.BaseCaseOption(func(v int) bool { return v == 3 }))
+	want := tuples.Edges([3]int{1, 2, 1}, [3]int{2, 3, 1})
+	if got := slices.Collect(p.Edges()); !slices.Equal(got, want) || p.Cost != 2 {
+		t.Errorf("DijkstraPath(1, 3) = %v with cost %v, want %v with cost 2", got, p.Cost, want)
+	}
+}
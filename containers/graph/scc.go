@@ -0,0 +1,196 @@
+package graph
+
+import (
+	"iter"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/hashgraph"
+	"github.com/elordeiro/goext/containers/set"
+)
+
+// frame is an explicit stack frame used by the iterative depth-first searches
+// in this package. It holds a vertex and the neighbors that are still left to
+// be explored.
+type frame[V comparable] struct {
+	v  V
+	ns []V
+	i  int
+}
+
+// newFrame returns a frame for the vertex v with all of its neighbors collected.
+func newFrame[V comparable, N constraints.Number](g Graph[V, N], v V) frame[V] {
+	ns := []V{}
+	for dst := range g.Neighbors(v) {
+		ns = append(ns, dst)
+	}
+	return frame[V]{v: v, ns: ns}
+}
+
+// SCC returns an iter.Seq[set.Set[V]] over the strongly connected components of
+// the graph g. The components are yielded in reverse topological order, that is,
+// a component is yielded before any of the components that can reach it.
+// For undirected graphs the components are the connected components of the graph.
+// The algorithm used is an iterative version of Tarjan's algorithm, so it does not
+// grow the call stack with the depth of the graph.
+func SCC[V comparable, N constraints.Number](g Graph[V, N]) iter.Seq[set.Set[V]] {
+	return func(yield func(set.Set[V]) bool) {
+		index := map[V]int{}
+		low := map[V]int{}
+		onStack := set.New[V]()
+		stack := []V{}
+		frames := []frame[V]{}
+		counter := 0
+
+		visit := func(v V) {
+			index[v], low[v] = counter, counter
+			counter++
+			stack = append(stack, v)
+			onStack.Add(v)
+			frames = append(frames, newFrame(g, v))
+		}
+
+		for start := range g.Vertices() {
+			if _, ok := index[start]; ok {
+				continue
+			}
+			visit(start)
+			for len(frames) > 0 {
+				f := &frames[len(frames)-1]
+				if f.i < len(f.ns) {
+					dst := f.ns[f.i]
+					f.i++
+					if _, ok := index[dst]; !ok {
+						visit(dst)
+					} else if onStack.Contains(dst) {
+						low[f.v] = min(low[f.v], index[dst])
+					}
+					continue
+				}
+
+				v := f.v
+				frames = frames[:len(frames)-1]
+				if len(frames) > 0 {
+					parent := frames[len(frames)-1].v
+					low[parent] = min(low[parent], low[v])
+				}
+				if low[v] != index[v] {
+					continue
+				}
+
+				// v is the root of a component, pop it off the stack
+				component := set.New[V]()
+				for {
+					w := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					onStack.Remove(w)
+					component.Add(w)
+					if w == v {
+						break
+					}
+				}
+				if !yield(component) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Kosaraju returns an iter.Seq[set.Set[V]] over the strongly connected components
+// of the graph g. The components are yielded in topological order, that is, a
+// component is yielded before any of the components it can reach.
+// The algorithm used is Kosaraju's algorithm, with both passes being iterative.
+func Kosaraju[V comparable, N constraints.Number](g Graph[V, N]) iter.Seq[set.Set[V]] {
+	return func(yield func(set.Set[V]) bool) {
+		// first pass: record the vertices in order of completion and build
+		// the transpose adjacency list along the way
+		visited := set.New[V]()
+		order := []V{}
+		reverse := map[V][]V{}
+		for start := range g.Vertices() {
+			if visited.Contains(start) {
+				continue
+			}
+			visited.Add(start)
+			frames := []frame[V]{newFrame(g, start)}
+			for len(frames) > 0 {
+				f := &frames[len(frames)-1]
+				if f.i < len(f.ns) {
+					dst := f.ns[f.i]
+					f.i++
+					reverse[dst] = append(reverse[dst], f.v)
+					if !visited.Contains(dst) {
+						visited.Add(dst)
+						frames = append(frames, newFrame(g, dst))
+					}
+					continue
+				}
+				order = append(order, f.v)
+				frames = frames[:len(frames)-1]
+			}
+		}
+
+		// second pass: flood the transpose graph in reverse order of completion
+		assigned := set.New[V]()
+		for i := len(order) - 1; i >= 0; i-- {
+			root := order[i]
+			if assigned.Contains(root) {
+				continue
+			}
+			assigned.Add(root)
+			component := set.New(root)
+			stack := []V{root}
+			for len(stack) > 0 {
+				v := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				for _, u := range reverse[v] {
+					if !assigned.Contains(u) {
+						assigned.Add(u)
+						component.Add(u)
+						stack = append(stack, u)
+					}
+				}
+			}
+			if !yield(component) {
+				return
+			}
+		}
+	}
+}
+
+// Condensation returns the condensation of the graph g, a directed acyclic graph
+// in which every strongly connected component of g is contracted into a single
+// vertex. The vertices of the condensation are component IDs numbered in
+// topological order starting at 0. The edge between two components carries the
+// minimum weight of the edges that connect them in g. The second return value
+// maps every vertex of g to the ID of its component.
+func Condensation[V comparable, N constraints.Number](g Graph[V, N]) (*hashgraph.HashGraph[int, N], map[V]int) {
+	components := []set.Set[V]{}
+	for c := range SCC(g) {
+		components = append(components, c)
+	}
+
+	// SCC yields the components in reverse topological order
+	ids := map[V]int{}
+	dag := hashgraph.New[int, N](true)
+	for i, c := range components {
+		id := len(components) - 1 - i
+		dag.AddVertex(id)
+		for v := range c {
+			ids[v] = id
+		}
+	}
+
+	for e := range g.Edges() {
+		src, dst := ids[e.Src()], ids[e.Dst()]
+		if src == dst {
+			continue
+		}
+		if w, err := dag.EdgeWeight(src, dst); err == nil && w <= e.Weight() {
+			continue
+		}
+		dag.AddEdge(src, dst, e.Weight())
+	}
+
+	return dag, ids
+}
@@ -0,0 +1,150 @@
+package graph_test
+
+import (
+	"iter"
+	"testing"
+
+	"github.com/elordeiro/goext/containers/graph"
+	"github.com/elordeiro/goext/containers/hashgraph"
+	"github.com/elordeiro/goext/containers/set"
+)
+
+// sameComponents returns true if the components yielded by got are exactly the
+// components in want, in any order.
+func sameComponents(got iter.Seq[set.Set[int]], want [][]int) bool {
+	n := 0
+	for c := range got {
+		found := false
+		for _, w := range want {
+			if c.Equal(set.New(w...)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+		n++
+	}
+	return n == len(want)
+}
+
+func TestSCC(t *testing.T) {
+	tests := []struct {
+		name     string
+		edges    [][2]int
+		directed bool
+		want     [][]int
+	}{
+		{
+			"single cycle",
+			[][2]int{{1, 2}, {2, 3}, {3, 1}},
+			true,
+			[][]int{{1, 2, 3}},
+		},
+		{
+			"dag",
+			[][2]int{{1, 2}, {2, 3}, {1, 3}},
+			true,
+			[][]int{{1}, {2}, {3}},
+		},
+		{
+			"two cycles joined",
+			[][2]int{{1, 2}, {2, 1}, {2, 3}, {3, 4}, {4, 5}, {5, 3}, {5, 6}},
+			true,
+			[][]int{{1, 2}, {3, 4, 5}, {6}},
+		},
+		{
+			"classic",
+			[][2]int{{1, 2}, {2, 3}, {3, 1}, {4, 2}, {4, 3}, {4, 5}, {5, 4}, {5, 6}, {6, 3}, {6, 7}, {7, 6}, {8, 7}, {8, 8}},
+			true,
+			[][]int{{1, 2, 3}, {4, 5}, {6, 7}, {8}},
+		},
+		{
+			"undirected",
+			[][2]int{{1, 2}, {2, 3}, {4, 5}},
+			false,
+			[][]int{{1, 2, 3}, {4, 5}},
+		},
+	}
+
+	for _, tc := range tests {
+		g := hashgraph.New[int, int](tc.directed)
+		for _, e := range tc.edges {
+			g.AddEdge(e[0], e[1])
+		}
+
+		t.Run(tc.name, func(t *testing.T) {
+			if !sameComponents(graph.SCC(g), tc.want) {
+				t.Errorf("SCC(%v) = %v, want %v", g, collectSets(graph.SCC(g)), tc.want)
+			}
+			if !sameComponents(graph.Kosaraju(g), tc.want) {
+				t.Errorf("Kosaraju(%v) = %v, want %v", g, collectSets(graph.Kosaraju(g)), tc.want)
+			}
+		})
+	}
+}
+
+func TestSCCDeepGraph(t *testing.T) {
+	const n = 100000
+	g := hashgraph.New[int, int](true)
+	for i := range n - 1 {
+		g.AddEdge(i, i+1)
+	}
+	g.AddEdge(n-1, 0)
+
+	if !sameComponents(graph.SCC(g), [][]int{collectRange(n)}) {
+		t.Errorf("SCC() on a %d vertex cycle did not return a single component", n)
+	}
+	if !sameComponents(graph.Kosaraju(g), [][]int{collectRange(n)}) {
+		t.Errorf("Kosaraju() on a %d vertex cycle did not return a single component", n)
+	}
+}
+
+func TestCondensation(t *testing.T) {
+	g := hashgraph.New[int, int](true)
+	g.AddEdge(1, 2, 4)
+	g.AddEdge(2, 1, 1)
+	g.AddEdge(2, 3, 7)
+	g.AddEdge(1, 3, 5)
+	g.AddEdge(3, 4, 1)
+	g.AddEdge(4, 3, 1)
+	g.AddEdge(4, 5, 2)
+
+	dag, ids := graph.Condensation(g)
+
+	if got := dag.VertexCount(); got != 3 {
+		t.Errorf("VertexCount() = %v, want 3", got)
+	}
+	if graph.HasCycle(dag) {
+		t.Errorf("HasCycle() = true, want false")
+	}
+	if ids[1] != ids[2] || ids[3] != ids[4] || ids[1] == ids[3] || ids[3] == ids[5] {
+		t.Errorf("Condensation() ids = %v", ids)
+	}
+	if !(ids[1] < ids[3] && ids[3] < ids[5]) {
+		t.Errorf("Condensation() ids = %v, want topological order", ids)
+	}
+	if w, err := dag.EdgeWeight(ids[1], ids[3]); err != nil || w != 5 {
+		t.Errorf("EdgeWeight(%v, %v) = %v, %v, want 5", ids[1], ids[3], w, err)
+	}
+	if w, err := dag.EdgeWeight(ids[3], ids[5]); err != nil || w != 2 {
+		t.Errorf("EdgeWeight(%v, %v) = %v, %v, want 2", ids[3], ids[5], w, err)
+	}
+}
+
+func collectSets(seq iter.Seq[set.Set[int]]) []set.Set[int] {
+	var res []set.Set[int]
+	for s := range seq {
+		res = append(res, s)
+	}
+	return res
+}
+
+func collectRange(n int) []int {
+	res := make([]int, n)
+	for i := range n {
+		res[i] = i
+	}
+	return res
+}
@@ -0,0 +1,97 @@
+package graph
+
+import (
+	"iter"
+	"maps"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/tuples"
+	extMath "github.com/elordeiro/goext/math"
+)
+
+// ShortestPathTree holds the shortest paths from a single source vertex to every
+// vertex reached by a search, so that one search can answer many queries.
+type ShortestPathTree[V comparable, N constraints.Number] struct {
+	start V
+	dist  map[V]N
+	prev  map[V]tuples.Pair[V, N]
+}
+
+// DijkstraAll performs a Dijkstra search on the graph g starting from the vertex start
+// and returns the ShortestPathTree of every vertex reachable from it. Edge weights must
+// not be negative. The algorithm can be configured with the following options:
+//
+//   - BaseCaseOption: return true if searching should stop, false otherwise. The tree
+//     then only holds the vertices settled up to and including the one that satisfied
+//     the base case.
+//   - EarlyReturnOption: return true if the search should stop at src because of the
+//     edge to a settled or filtered vertex dst, false otherwise. The tree is cut the
+//     same way as for the base case.
+//   - VertexFilterOption: return true if the edge from src to dst should be considered,
+//     false otherwise.
+//   - PreVisitOption: execute a process with src and dst prior to relaxing an edge.
+//   - DeferredOption: execute a process with a source vertex once all of its neighbors
+//     have been relaxed.
+func DijkstraAll[V comparable, N constraints.Number](
+	g Traversable[V, N],
+	start V,
+	options ...option[V],
+) *ShortestPathTree[V, N] {
+	dist, prev, _, _ := dijkstraAll(g, start, setOptions(options...))
+	return &ShortestPathTree[V, N]{start: start, dist: dist, prev: prev}
+}
+
+// Start returns the source vertex of the tree.
+func (t *ShortestPathTree[V, N]) Start() V {
+	return t.start
+}
+
+// Dist returns the length of the shortest path from the source to the vertex v.
+// If v is not reachable, the maximum value of N is returned.
+func (t *ShortestPathTree[V, N]) Dist(v V) N {
+	if d, ok := t.dist[v]; ok {
+		return d
+	}
+	return extMath.Inf[N]()
+}
+
+// HasPathTo returns true if the vertex v is reachable from the source and false
+// otherwise. The source is always reachable from itself.
+func (t *ShortestPathTree[V, N]) HasPathTo(v V) bool {
+	_, ok := t.dist[v]
+	return ok
+}
+
+// PathTo returns an iter.Seq[tuples.Edge[V, N]] over the edges of the shortest path
+// from the source to the vertex v. If v is not reachable, or if v is the source,
+// an empty sequence is returned.
+func (t *ShortestPathTree[V, N]) PathTo(v V) iter.Seq[tuples.Edge[V, N]] {
+	if !t.HasPathTo(v) {
+		return func(yield func(tuples.Edge[V, N]) bool) {}
+	}
+	return rebuildPath(v, t.start, t.prev)
+}
+
+// Parent returns the vertex that precedes v on the shortest path from the source.
+// If v is the source or is not reachable, false is returned.
+func (t *ShortestPathTree[V, N]) Parent(v V) (V, bool) {
+	p, ok := t.prev[v]
+	return p.Left(), ok
+}
+
+// Reachable returns an iter.Seq[V] over all the vertices reachable from the source,
+// including the source itself.
+func (t *ShortestPathTree[V, N]) Reachable() iter.Seq[V] {
+	return maps.Keys(t.dist)
+}
+
+// Edges returns an iter.Seq[tuples.Edge[V, N]] over the edges of the tree.
+func (t *ShortestPathTree[V, N]) Edges() iter.Seq[tuples.Edge[V, N]] {
+	return func(yield func(tuples.Edge[V, N]) bool) {
+		for v, p := range t.prev {
+			if !yield(tuples.NewEdge(p.Left(), v, p.Right())) {
+				return
+			}
+		}
+	}
+}
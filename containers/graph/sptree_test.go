@@ -0,0 +1,77 @@
+package graph_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/elordeiro/goext/containers/graph"
+	"github.com/elordeiro/goext/containers/hashgraph"
+	"github.com/elordeiro/goext/containers/set"
+	"github.com/elordeiro/goext/containers/tuples"
+	extMath "github.com/elordeiro/goext/math"
+	"github.com/elordeiro/goext/seqs"
+)
+
+func TestDijkstraAll(t *testing.T) {
+	g := hashgraph.New[int, int](false)
+	for _, e := range [][3]int{
+		{1, 2, 7}, {1, 3, 9}, {1, 6, 14}, {2, 3, 10}, {2, 4, 15}, {3, 4, 11}, {3, 6, 2}, {4, 5, 6}, {5, 6, 9},
+	} {
+		g.AddEdge(e[0], e[1], e[2])
+	}
+	g.AddEdge(7, 8, 1)
+
+	spt := graph.DijkstraAll(g, 1)
+
+	dist := map[int]int{1: 0, 2: 7, 3: 9, 4: 20, 5: 20, 6: 11}
+	for v, want := range dist {
+		if got := spt.Dist(v); got != want {
+			t.Errorf("Dist(%v) = %v, want %v", v, got, want)
+		}
+	}
+
+	if got := set.New(slices.Collect(spt.Reachable())...); !got.Equal(set.New(1, 2, 3, 4, 5, 6)) {
+		t.Errorf("Reachable() = %v, want {1 2 3 4 5 6}", got)
+	}
+
+	want := slices.Values(tuples.Edges([][3]int{{1, 3, 9}, {3, 6, 2}, {6, 5, 9}}...))
+	if got := spt.PathTo(5); !seqs.Equal(got, want) {
+		t.Errorf("PathTo(5) = %s, want %s", seqs.String(got), seqs.String(want))
+	}
+
+	if p, ok := spt.Parent(4); !ok || p != 3 {
+		t.Errorf("Parent(4) = %v, %v, want 3, true", p, ok)
+	}
+	if _, ok := spt.Parent(1); ok {
+		t.Errorf("Parent(1) = _, true, want false")
+	}
+
+	if spt.HasPathTo(7) || spt.Dist(7) != extMath.Inf[int]() || !seqs.IsEmpty(spt.PathTo(7)) {
+		t.Errorf("Dist(7) = %v, want unreachable", spt.Dist(7))
+	}
+	if !spt.HasPathTo(1) || !seqs.IsEmpty(spt.PathTo(1)) {
+		t.Errorf("PathTo(1) = %v, want empty path", seqs.String(spt.PathTo(1)))
+	}
+
+	if got := seqs.Len(spt.Edges()); got != 5 {
+		t.Errorf("len(Edges()) = %v, want 5", got)
+	}
+}
+
+func TestDijkstraAllOptions(t *testing.T) {
+	g := hashgraph.New[int, int](true)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(2, 3, 1)
+	g.AddEdge(1, 3, 5)
+	g.AddEdge(3, 4, 1)
+
+	spt := graph.DijkstraAll(g, 1, graph.VertexFilterOption(func(src, dst int) bool { return dst != 2 }))
+	if got := spt.Dist(3); got != 5 {
+		t.Errorf("Dist(3) = %v, want 5", got)
+	}
+
+	spt = graph.DijkstraAll(g, 1, graph.BaseCaseOption(func(src int) bool { return src == 2 }))
+	if got := set.New(slices.Collect(spt.Reachable())...); !got.Equal(set.New(1, 2)) {
+		t.Errorf("Reachable() = %v, want {1 2}", got)
+	}
+}
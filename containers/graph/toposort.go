@@ -0,0 +1,151 @@
+package graph
+
+import (
+	"iter"
+	"slices"
+	"strings"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/set"
+	"github.com/elordeiro/goext/containers/tuples"
+)
+
+// CycleError is returned when an algorithm requires an acyclic graph and a cycle
+// is found. Cycle holds the edges of the offending cycle in order, so that the
+// destination of the last edge is the source of the first.
+type CycleError[V comparable, N constraints.Number] struct {
+	Cycle []tuples.Edge[V, N]
+}
+
+func (e CycleError[V, N]) Error() string {
+	var sb strings.Builder
+	sb.WriteString("graph has a cycle:")
+	for _, edge := range e.Cycle {
+		sb.WriteByte(' ')
+		sb.WriteString(edge.String())
+	}
+	return sb.String()
+}
+
+// TopologicalSort returns an iter.Seq[V] over the vertices of the directed graph g
+// in topological order, where every vertex comes before all the vertices it has an
+// edge to. If the graph has a cycle, an empty sequence and a CycleError carrying one
+// of the cycles are returned. The algorithm used is Kahn's algorithm.
+// TopologicalSort panics if the graph is undirected.
+func TopologicalSort[V comparable, N constraints.Number](g Graph[V, N]) (iter.Seq[V], error) {
+	layers, err := kahn(g)
+	if err != nil {
+		return func(yield func(V) bool) {}, err
+	}
+	return func(yield func(V) bool) {
+		for _, layer := range layers {
+			for _, v := range layer {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}, nil
+}
+
+// TopologicalLayers returns an iter.Seq[[]V] over the layers of the directed graph g.
+// The first layer holds the vertices without incoming edges and every following
+// layer holds the vertices whose predecessors all belong to earlier layers, so the
+// vertices within a layer are independent of each other. If the graph has a cycle,
+// an empty sequence and a CycleError carrying one of the cycles are returned.
+// TopologicalLayers panics if the graph is undirected.
+func TopologicalLayers[V comparable, N constraints.Number](g Graph[V, N]) (iter.Seq[[]V], error) {
+	layers, err := kahn(g)
+	if err != nil {
+		return func(yield func([]V) bool) {}, err
+	}
+	return slices.Values(layers), nil
+}
+
+// kahn runs Kahn's algorithm on g and returns its vertices grouped by layer.
+func kahn[V comparable, N constraints.Number](g Graph[V, N]) ([][]V, error) {
+	if !g.IsDirected() {
+		panic("graph is undirected")
+	}
+
+	indegree := map[V]int{}
+	for v := range g.Vertices() {
+		indegree[v] += 0
+		for dst := range g.Neighbors(v) {
+			indegree[dst]++
+		}
+	}
+
+	layer := []V{}
+	for v, d := range indegree {
+		if d == 0 {
+			layer = append(layer, v)
+		}
+	}
+
+	layers := [][]V{}
+	sorted := 0
+	for len(layer) > 0 {
+		layers = append(layers, layer)
+		sorted += len(layer)
+		next := []V{}
+		for _, src := range layer {
+			for dst := range g.Neighbors(src) {
+				indegree[dst]--
+				if indegree[dst] == 0 {
+					next = append(next, dst)
+				}
+			}
+		}
+		layer = next
+	}
+
+	if sorted < len(indegree) {
+		return nil, CycleError[V, N]{Cycle: leftoverCycle(g, indegree)}
+	}
+	return layers, nil
+}
+
+// leftoverCycle returns the edges of a cycle among the vertices left over by Kahn's
+// algorithm, which are the ones whose indegree never reached zero. Every one of
+// them has a predecessor that was left over too, so walking predecessors must
+// eventually revisit a vertex.
+func leftoverCycle[V comparable, N constraints.Number](g Graph[V, N], indegree map[V]int) []tuples.Edge[V, N] {
+	remaining := set.New[V]()
+	for v, d := range indegree {
+		if d > 0 {
+			remaining.Add(v)
+		}
+	}
+
+	pred := map[V]tuples.Edge[V, N]{}
+	for src := range remaining {
+		for dst, w := range g.Neighbors(src) {
+			if remaining.Contains(dst) {
+				pred[dst] = tuples.NewEdge(src, dst, w)
+			}
+		}
+	}
+
+	var v V
+	for v = range remaining {
+		break
+	}
+	seen := set.New[V]()
+	for !seen.Contains(v) {
+		seen.Add(v)
+		v = pred[v].Src()
+	}
+
+	cycle := []tuples.Edge[V, N]{}
+	for start := v; ; {
+		e := pred[v]
+		cycle = append(cycle, e)
+		v = e.Src()
+		if v == start {
+			break
+		}
+	}
+	slices.Reverse(cycle)
+	return cycle
+}
@@ -0,0 +1,120 @@
+package graph_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/elordeiro/goext/containers/graph"
+	"github.com/elordeiro/goext/containers/hashgraph"
+	"github.com/elordeiro/goext/containers/set"
+	"github.com/elordeiro/goext/containers/tuples"
+)
+
+func TestTopologicalSort(t *testing.T) {
+	tests := []struct {
+		name  string
+		edges [][2]int
+	}{
+		{"chain", [][2]int{{1, 2}, {2, 3}, {3, 4}}},
+		{"diamond", [][2]int{{1, 2}, {1, 3}, {2, 4}, {3, 4}}},
+		{"forest", [][2]int{{1, 2}, {3, 4}, {5, 4}, {4, 6}}},
+	}
+
+	for _, tc := range tests {
+		g := hashgraph.New[int, int](true)
+		for _, e := range tc.edges {
+			g.AddEdge(e[0], e[1])
+		}
+
+		t.Run(tc.name, func(t *testing.T) {
+			order, err := graph.TopologicalSort(g)
+			if err != nil {
+				t.Fatalf("TopologicalSort(%v) error = %v, want nil", g, err)
+			}
+			pos := map[int]int{}
+			for v := range order {
+				pos[v] = len(pos)
+			}
+			if len(pos) != g.VertexCount() {
+				t.Errorf("TopologicalSort(%v) returned %v vertices, want %v", g, len(pos), g.VertexCount())
+			}
+			for e := range g.Edges() {
+				if pos[e.Src()] >= pos[e.Dst()] {
+					t.Errorf("TopologicalSort(%v) = %v, %v comes after %v", g, pos, e.Src(), e.Dst())
+				}
+			}
+		})
+	}
+}
+
+func TestTopologicalSortCycle(t *testing.T) {
+	tests := []struct {
+		name  string
+		edges [][3]int
+		want  [][3]int
+	}{
+		{"self loop", [][3]int{{1, 2, 1}, {2, 2, 5}}, [][3]int{{2, 2, 5}}},
+		{"triangle", [][3]int{{0, 1, 1}, {1, 2, 2}, {2, 3, 3}, {3, 1, 4}, {3, 4, 5}}, [][3]int{{1, 2, 2}, {2, 3, 3}, {3, 1, 4}}},
+	}
+
+	for _, tc := range tests {
+		g := hashgraph.New[int, int](true)
+		for _, e := range tc.edges {
+			g.AddEdge(e[0], e[1], e[2])
+		}
+
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := graph.TopologicalSort(g)
+			var cycleErr graph.CycleError[int, int]
+			if !errors.As(err, &cycleErr) {
+				t.Fatalf("TopologicalSort(%v) error = %v, want CycleError", g, err)
+			}
+
+			got := set.New(cycleErr.Cycle...)
+			want := set.New(tuples.Edges(tc.want...)...)
+			if !got.Equal(want) {
+				t.Errorf("TopologicalSort(%v) cycle = %v, want %v", g, cycleErr.Cycle, tc.want)
+			}
+			for i, e := range cycleErr.Cycle {
+				next := cycleErr.Cycle[(i+1)%len(cycleErr.Cycle)]
+				if e.Dst() != next.Src() {
+					t.Errorf("TopologicalSort(%v) cycle = %v, edges are not consecutive", g, cycleErr.Cycle)
+				}
+			}
+		})
+	}
+}
+
+func TestTopologicalLayers(t *testing.T) {
+	g := hashgraph.New[string, int](true)
+	g.AddEdge("a", "c")
+	g.AddEdge("b", "c")
+	g.AddEdge("c", "d")
+	g.AddEdge("c", "e")
+	g.AddEdge("b", "e")
+	g.AddEdge("d", "f")
+	g.AddEdge("e", "f")
+
+	want := []set.Set[string]{set.New("a", "b"), set.New("c"), set.New("d", "e"), set.New("f")}
+
+	layers, err := graph.TopologicalLayers(g)
+	if err != nil {
+		t.Fatalf("TopologicalLayers(%v) error = %v, want nil", g, err)
+	}
+
+	i := 0
+	for layer := range layers {
+		if i >= len(want) || !set.New(layer...).Equal(want[i]) {
+			t.Errorf("TopologicalLayers(%v) layer %d = %v, want %v", g, i, layer, want)
+		}
+		i++
+	}
+	if i != len(want) {
+		t.Errorf("TopologicalLayers(%v) returned %d layers, want %d", g, i, len(want))
+	}
+
+	g.AddEdge("f", "a")
+	if _, err := graph.TopologicalLayers(g); err == nil {
+		t.Errorf("TopologicalLayers(%v) error = nil, want CycleError", g)
+	}
+}
@@ -0,0 +1,241 @@
+// Package gridgraph provides a graph over the cells of a 2D grid. The edges of the
+// graph are computed on demand from the grid, so no adjacency list is ever stored.
+package gridgraph
+
+import (
+	"fmt"
+	"iter"
+	"math"
+	"strings"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/tuples"
+	extMath "github.com/elordeiro/goext/math"
+)
+
+// Connectivity is the number of neighbors of a cell in the grid.
+type Connectivity int
+
+const (
+	// FourWay connects a cell to the cells above, below, left and right of it.
+	FourWay Connectivity = 4
+	// EightWay also connects a cell to the cells diagonal to it.
+	EightWay Connectivity = 8
+)
+
+// options holds the configuration of a GridGraph
+type options struct {
+	connectivity Connectivity
+	diagonal     float64
+	blocked      func(tuples.Cell[int]) bool
+}
+
+// option defines the signature of the functions that can be used to configure a
+// GridGraph
+type option func(*options)
+
+// ConnectivityOption returns an option that sets the connectivity of the grid. The
+// default is FourWay.
+func ConnectivityOption(c Connectivity) option {
+	return func(o *options) {
+		o.connectivity = c
+	}
+}
+
+// DiagonalCostOption returns an option that sets the factor the cost of a cell is
+// multiplied by when it is entered diagonally. The default is math.Sqrt2. For integer
+// weights, the product is rounded up, so that with the default factor a diagonal move
+// still costs more than an orthogonal one, such as 2 instead of 1 for unit costs.
+func DiagonalCostOption(factor float64) option {
+	return func(o *options) {
+		o.diagonal = factor
+	}
+}
+
+// BlockedOption returns an option that sets the predicate for blocked cells. Blocked
+// cells are not vertices of the graph, so they can never be entered. The predicate is
+// called on every query, so the grid may change between searches.
+func BlockedOption(predicate func(cell tuples.Cell[int]) bool) option {
+	return func(o *options) {
+		o.blocked = predicate
+	}
+}
+
+// GridGraph is a directed graph over the cells of a grid with rows rows and cols
+// columns, keyed by tuples.Cell[int]. Every cell that is not blocked is a vertex, and
+// has an edge to every neighboring cell that is not blocked. A diagonal move is only
+// allowed if both cells it passes by are not blocked, so it never cuts the corner of
+// a blocked cell. The weight of an edge is
+// the cost of the cell it enters, multiplied by the diagonal factor for diagonal
+// moves. The graph is directed because the costs of two neighboring cells may differ.
+type GridGraph[N constraints.Number] struct {
+	rows, cols int
+	cost       func(tuples.Cell[int]) N
+	opts       options
+}
+
+// New returns a GridGraph with rows rows and cols columns, where cost returns the
+// cost of entering a cell.
+func New[N constraints.Number](rows, cols int, cost func(cell tuples.Cell[int]) N, opts ...option) *GridGraph[N] {
+	g := &GridGraph[N]{
+		rows: rows,
+		cols: cols,
+		cost: cost,
+		opts: options{
+			connectivity: FourWay,
+			diagonal:     math.Sqrt2,
+			blocked:      func(tuples.Cell[int]) bool { return false },
+		},
+	}
+	for _, opt := range opts {
+		opt(&g.opts)
+	}
+	return g
+}
+
+// FromGrid returns a GridGraph over the cells of grid, where cost returns the cost of
+// entering a cell from its value. The grid is read on every query and is not copied.
+// Rows shorter than the first one leave their missing cells blocked.
+func FromGrid[T any, N constraints.Number](grid [][]T, cost func(value T) N, opts ...option) *GridGraph[N] {
+	cols := 0
+	if len(grid) > 0 {
+		cols = len(grid[0])
+	}
+	g := New(len(grid), cols, func(cell tuples.Cell[int]) N {
+		return cost(grid[cell.Row()][cell.Col()])
+	}, opts...)
+
+	blocked := g.opts.blocked
+	g.opts.blocked = func(cell tuples.Cell[int]) bool {
+		return cell.Col() >= len(grid[cell.Row()]) || blocked(cell)
+	}
+	return g
+}
+
+// Rows returns the number of rows of the grid.
+func (g GridGraph[N]) Rows() int {
+	return g.rows
+}
+
+// Cols returns the number of columns of the grid.
+func (g GridGraph[N]) Cols() int {
+	return g.cols
+}
+
+// HasVertex returns true if the cell is inside the grid and is not blocked, and false
+// otherwise.
+func (g GridGraph[N]) HasVertex(cell tuples.Cell[int]) bool {
+	r, c := cell.Row(), cell.Col()
+	return r >= 0 && r < g.rows && c >= 0 && c < g.cols && !g.opts.blocked(cell)
+}
+
+// IsDirected returns true, since the cost of entering a cell depends on the cell.
+func (g GridGraph[N]) IsDirected() bool {
+	return true
+}
+
+// VertexCount returns the number of cells that are not blocked. It checks every cell
+// of the grid.
+func (g GridGraph[N]) VertexCount() int {
+	count := 0
+	for range g.Vertices() {
+		count++
+	}
+	return count
+}
+
+// Vertices returns an iter.Seq[tuples.Cell[int]] over the cells that are not blocked,
+// row by row.
+func (g GridGraph[N]) Vertices() iter.Seq[tuples.Cell[int]] {
+	return func(yield func(tuples.Cell[int]) bool) {
+		for r := range g.rows {
+			for c := range g.cols {
+				cell := tuples.NewCell(r, c)
+				if !g.opts.blocked(cell) && !yield(cell) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Edges returns an iter.Seq[tuples.Edge[tuples.Cell[int], N]] over every edge of the
+// graph.
+func (g GridGraph[N]) Edges() iter.Seq[tuples.Edge[tuples.Cell[int], N]] {
+	return func(yield func(tuples.Edge[tuples.Cell[int], N]) bool) {
+		for src := range g.Vertices() {
+			for dst, w := range g.Neighbors(src) {
+				if !yield(tuples.NewEdge(src, dst, w)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// steps holds the moves to the neighbors of a cell, orthogonal moves first
+var steps = [8][2]int{{-1, 0}, {0, 1}, {1, 0}, {0, -1}, {-1, 1}, {1, 1}, {1, -1}, {-1, -1}}
+
+// Neighbors returns an iter.Seq2[tuples.Cell[int], N] over the cells that can be
+// entered from the cell along with the cost of entering them. If the cell is not a
+// vertex of the graph, an empty sequence is returned.
+func (g GridGraph[N]) Neighbors(cell tuples.Cell[int]) iter.Seq2[tuples.Cell[int], N] {
+	return func(yield func(tuples.Cell[int], N) bool) {
+		if !g.HasVertex(cell) {
+			return
+		}
+		n := 4
+		if g.opts.connectivity == EightWay {
+			n = 8
+		}
+		for i, step := range steps[:n] {
+			dst := tuples.NewCell(cell.Row()+step[0], cell.Col()+step[1])
+			if !g.HasVertex(dst) {
+				continue
+			}
+			w := g.cost(dst)
+			if i >= 4 {
+				// the orthogonal neighbors the diagonal move passes by
+				if g.opts.blocked(tuples.NewCell(cell.Row()+step[0], cell.Col())) ||
+					g.opts.blocked(tuples.NewCell(cell.Row(), cell.Col()+step[1])) {
+					continue
+				}
+				w = g.diagonalCost(w)
+			}
+			if !yield(dst, w) {
+				return
+			}
+		}
+	}
+}
+
+// diagonalCost returns the cost w multiplied by the diagonal factor, rounded up for
+// integer weights.
+func (g GridGraph[N]) diagonalCost(w N) N {
+	d := float64(w) * g.opts.diagonal
+	if extMath.IsIntegral[N]() {
+		d = math.Ceil(d)
+	}
+	return N(d)
+}
+
+// String returns a string representation of the grid, with the cost of entering every
+// cell and # for blocked cells.
+func (g GridGraph[N]) String() string {
+	var sb strings.Builder
+	for r := range g.rows {
+		for c := range g.cols {
+			if c > 0 {
+				sb.WriteByte(' ')
+			}
+			cell := tuples.NewCell(r, c)
+			if g.opts.blocked(cell) {
+				sb.WriteByte('#')
+			} else {
+				fmt.Fprint(&sb, g.cost(cell))
+			}
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
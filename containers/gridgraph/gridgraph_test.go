@@ -0,0 +1,137 @@
+package gridgraph_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/elordeiro/goext/containers/graph"
+	"github.com/elordeiro/goext/containers/gridgraph"
+	"github.com/elordeiro/goext/containers/tuples"
+	"github.com/elordeiro/goext/seq2s"
+	"github.com/elordeiro/goext/seqs"
+)
+
+var maze = [][]byte{
+	[]byte("....#"),
+	[]byte(".##.#"),
+	[]byte("...#."),
+	[]byte("#...."),
+}
+
+func wall(cell tuples.Cell[int]) bool {
+	return maze[cell.Row()][cell.Col()] == '#'
+}
+
+func TestGridGraph(t *testing.T) {
+	g := gridgraph.FromGrid(maze, func(b byte) int { return 1 }, gridgraph.BlockedOption(wall))
+
+	if got := g.VertexCount(); got != 14 {
+		t.Errorf("VertexCount() = %v, want 14", got)
+	}
+	if !g.HasVertex(tuples.NewCell(0, 0)) || g.HasVertex(tuples.NewCell(0, 4)) || g.HasVertex(tuples.NewCell(-1, 0)) {
+		t.Errorf("HasVertex() does not match the maze")
+	}
+	if got := seq2s.Len(g.Neighbors(tuples.NewCell(2, 1))); got != 3 {
+		t.Errorf("len(Neighbors((2, 1))) = %v, want 3", got)
+	}
+	if got := seq2s.Len(g.Neighbors(tuples.NewCell(1, 1))); got != 0 {
+		t.Errorf("len(Neighbors((1, 1))) = %v, want 0", got)
+	}
+
+	eight := gridgraph.FromGrid(maze, func(b byte) int { return 1 },
+		gridgraph.BlockedOption(wall), gridgraph.ConnectivityOption(gridgraph.EightWay))
+	if got := seq2s.Len(eight.Neighbors(tuples.NewCell(2, 1))); got != 4 {
+		t.Errorf("len(Neighbors((2, 1))) = %v, want 4", got)
+	}
+}
+
+func TestGridGraphCorner(t *testing.T) {
+	// (0, 1) is blocked, so the diagonal moves between (0, 0) and (1, 1) would cut
+	// its corner
+	blocked := tuples.NewCell(0, 1)
+	g := gridgraph.New(2, 2, func(tuples.Cell[int]) float64 { return 1 },
+		gridgraph.ConnectivityOption(gridgraph.EightWay),
+		gridgraph.BlockedOption(func(c tuples.Cell[int]) bool { return c == blocked }))
+
+	for _, c := range [][2]tuples.Cell[int]{
+		{tuples.NewCell(0, 0), tuples.NewCell(1, 1)},
+		{tuples.NewCell(1, 1), tuples.NewCell(0, 0)},
+	} {
+		for dst := range g.Neighbors(c[0]) {
+			if dst == c[1] {
+				t.Errorf("Neighbors(%v) has %v, want the blocked corner not cut", c[0], c[1])
+			}
+		}
+	}
+	// only the orthogonal moves between (0, 0), (1, 0) and (1, 1) are left
+	if got := seqs.Len(g.Edges()); got != 4 {
+		t.Errorf("len(Edges()) = %v, want 4", got)
+	}
+}
+
+func TestGridGraphAStar(t *testing.T) {
+	start, end := tuples.NewCell(0, 0), tuples.NewCell(2, 4)
+	goal := graph.BaseCaseOption(func(c tuples.Cell[int]) bool { return c == end })
+
+	g := gridgraph.FromGrid(maze, func(b byte) int { return 1 }, gridgraph.BlockedOption(wall))
+	path := graph.AStar(g, start, graph.ManhattanHeuristic(end), goal)
+	if got := seqs.Len(path); got != 8 {
+		t.Errorf("len(AStar(4-way)) = %v, want 8", got)
+	}
+
+	eight := gridgraph.FromGrid(maze, func(b byte) float64 { return 1 },
+		gridgraph.BlockedOption(wall), gridgraph.ConnectivityOption(gridgraph.EightWay))
+	cost := 0.0
+	for e := range graph.AStar(eight, start, graph.OctileHeuristic(end), goal) {
+		cost += e.Weight()
+	}
+	want := 0.0
+	for e := range graph.Dijkstra(eight, start, goal) {
+		want += e.Weight()
+	}
+	if math.Abs(cost-want) > 1e-9 {
+		t.Errorf("AStar(8-way) cost = %v, want %v", cost, want)
+	}
+}
+
+func TestGridGraphCost(t *testing.T) {
+	g := gridgraph.New(3, 3, func(c tuples.Cell[int]) int { return c.Row() + 1 },
+		gridgraph.ConnectivityOption(gridgraph.EightWay), gridgraph.DiagonalCostOption(1.5))
+
+	for dst, w := range g.Neighbors(tuples.NewCell(0, 0)) {
+		want := dst.Row() + 1
+		if dst.Row() == 1 && dst.Col() == 1 {
+			want = 3 // 2 * 1.5
+		}
+		if w != want {
+			t.Errorf("weight of (0, 0) -> %v = %v, want %v", dst, w, want)
+		}
+	}
+	if got := seqs.Len(g.Edges()); got != 40 {
+		t.Errorf("len(Edges()) = %v, want 40", got)
+	}
+	if got, want := g.String(), "1 1 1\n2 2 2\n3 3 3\n"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	ragged := gridgraph.FromGrid([][]int{{1, 2, 3}, {4}}, func(v int) int { return v })
+	if got := ragged.VertexCount(); got != 4 {
+		t.Errorf("ragged VertexCount() = %v, want 4", got)
+	}
+}
+
+func TestGridGraphIntegerDiagonal(t *testing.T) {
+	g := gridgraph.New(2, 2, func(tuples.Cell[int]) int { return 1 },
+		gridgraph.ConnectivityOption(gridgraph.EightWay))
+
+	// the default factor rounds 1 * sqrt(2) up to 2
+	for dst, w := range g.Neighbors(tuples.NewCell(0, 0)) {
+		want := 1
+		if dst == tuples.NewCell(1, 1) {
+			want = 2
+		}
+		if w != want {
+			t.Errorf("weight of (0, 0) -> %v = %v, want %v", dst, w, want)
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package hashgraph
+
+import (
+	"iter"
+	"maps"
+	"reflect"
+	"slices"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/tuples"
+)
+
+// Attribute is a typed key for the attributes of the vertices and edges of a
+// HashGraph, and of the edges of a MultiGraph. An attribute is identified by its name
+// and the type T of its values, so attributes can be read without type assertions:
+//
+//	capacity := hashgraph.NewAttribute[float64]("capacity")
+//	hashgraph.SetEdgeAttribute(g, "a", "b", capacity, 2.5)
+//	c, ok := hashgraph.EdgeAttribute(g, "a", "b", capacity)
+//
+// Two attributes with the same name but different types are different attributes,
+// and setting one of them leaves the value of the other as it is.
+type Attribute[T any] struct {
+	name string
+}
+
+// NewAttribute returns the attribute with the given name and values of type T.
+func NewAttribute[T any](name string) Attribute[T] {
+	return Attribute[T]{name: name}
+}
+
+// Name returns the name of the attribute.
+func (a Attribute[T]) Name() string {
+	return a.name
+}
+
+// attributeKey identifies an attribute by its name and the type of its values
+type attributeKey struct {
+	name string
+	typ  reflect.Type
+}
+
+// key returns the key the values of the attribute are stored by.
+func (a Attribute[T]) key() attributeKey {
+	return attributeKey{name: a.name, typ: reflect.TypeFor[T]()}
+}
+
+// attributes holds the attributes of a vertex or an edge by key
+type attributes map[attributeKey]any
+
+// SetVertexAttribute sets the attribute of a vertex to value. If the vertex doesn't
+// exist, it returns a VertexError.
+func SetVertexAttribute[V comparable, N constraints.Number, T any](g *HashGraph[V, N], vertex V, attr Attribute[T], value T) error {
+	if !g.HasVertex(vertex) {
+		return VertexError[V]{vertex: vertex}
+	}
+	if g.vertexAttrs == nil {
+		g.vertexAttrs = map[V]attributes{}
+	}
+	if g.vertexAttrs[vertex] == nil {
+		g.vertexAttrs[vertex] = attributes{}
+	}
+	g.vertexAttrs[vertex][attr.key()] = value
+	return nil
+}
+
+// VertexAttribute returns the attribute of a vertex and true, or false if the vertex
+// doesn't have the attribute.
+func VertexAttribute[V comparable, N constraints.Number, T any](g *HashGraph[V, N], vertex V, attr Attribute[T]) (T, bool) {
+	value, ok := g.vertexAttrs[vertex][attr.key()].(T)
+	return value, ok
+}
+
+// DeleteVertexAttribute removes the attribute of a vertex. If the vertex doesn't have
+// the attribute, it is a no-op.
+func DeleteVertexAttribute[V comparable, N constraints.Number, T any](g *HashGraph[V, N], vertex V, attr Attribute[T]) {
+	delete(g.vertexAttrs[vertex], attr.key())
+}
+
+// SetEdgeAttribute sets the attribute of the edge between two vertices, src and dst,
+// to value. In undirected graphs the attribute is shared by both directions of the
+// edge. If the edge doesn't exist, it returns an EdgeError.
+func SetEdgeAttribute[V comparable, N constraints.Number, T any](g *HashGraph[V, N], src, dst V, attr Attribute[T], value T) error {
+	if !g.HasEdge(src, dst) {
+		return EdgeError[V, N]{src: src, dst: dst}
+	}
+	if g.edgeAttrs == nil {
+		g.edgeAttrs = map[[2]V]attributes{}
+	}
+	attrs := g.edgeAttrs[[2]V{src, dst}]
+	if attrs == nil {
+		attrs = attributes{}
+		g.edgeAttrs[[2]V{src, dst}] = attrs
+		if !g.isDirected {
+			g.edgeAttrs[[2]V{dst, src}] = attrs
+		}
+	}
+	attrs[attr.key()] = value
+	return nil
+}
+
+// EdgeAttribute returns the attribute of the edge between two vertices, src and dst,
+// and true, or false if the edge doesn't have the attribute.
+func EdgeAttribute[V comparable, N constraints.Number, T any](g *HashGraph[V, N], src, dst V, attr Attribute[T]) (T, bool) {
+	value, ok := g.edgeAttrs[[2]V{src, dst}][attr.key()].(T)
+	return value, ok
+}
+
+// DeleteEdgeAttribute removes the attribute of the edge between two vertices, src and
+// dst. If the edge doesn't have the attribute, it is a no-op.
+func DeleteEdgeAttribute[V comparable, N constraints.Number, T any](g *HashGraph[V, N], src, dst V, attr Attribute[T]) {
+	delete(g.edgeAttrs[[2]V{src, dst}], attr.key())
+}
+
+// VerticesWhere returns an iter.Seq2[V, T] over the vertices whose attribute satisfies
+// the predicate, along with the value of the attribute. Vertices without the
+// attribute are skipped. If predicate is nil, every vertex with the attribute is
+// yielded.
+func VerticesWhere[V comparable, N constraints.Number, T any](g *HashGraph[V, N], attr Attribute[T], predicate func(T) bool) iter.Seq2[V, T] {
+	return func(yield func(V, T) bool) {
+		for v, attrs := range g.vertexAttrs {
+			value, ok := attrs[attr.key()].(T)
+			if ok && (predicate == nil || predicate(value)) && !yield(v, value) {
+				return
+			}
+		}
+	}
+}
+
+// EdgesWhere returns an iter.Seq2[tuples.Edge[V, N], T] over the edges whose attribute
+// satisfies the predicate, along with the value of the attribute. Edges without the
+// attribute are skipped, and in undirected graphs every edge is yielded once. If
+// predicate is nil, every edge with the attribute is yielded.
+func EdgesWhere[V comparable, N constraints.Number, T any](g *HashGraph[V, N], attr Attribute[T], predicate func(T) bool) iter.Seq2[tuples.Edge[V, N], T] {
+	return func(yield func(tuples.Edge[V, N], T) bool) {
+		for e := range g.Edges() {
+			value, ok := g.edgeAttrs[[2]V{e.Src(), e.Dst()}][attr.key()].(T)
+			if ok && (predicate == nil || predicate(value)) && !yield(e, value) {
+				return
+			}
+		}
+	}
+}
+
+// SetMultiEdgeAttribute sets the attribute of the edge of a MultiGraph with the given
+// ID to value. If the edge doesn't exist, it returns an EdgeIDError.
+func SetMultiEdgeAttribute[V comparable, N constraints.Number, T any](g *MultiGraph[V, N], id EdgeID, attr Attribute[T], value T) error {
+	e, ok := g.edges[id]
+	if !ok {
+		return EdgeIDError{id: id}
+	}
+	if e.attributes == nil {
+		e.attributes = attributes{}
+	}
+	e.attributes[attr.key()] = value
+	return nil
+}
+
+// MultiEdgeAttribute returns the attribute of the edge of a MultiGraph with the given
+// ID and true, or false if the edge doesn't exist or doesn't have the attribute.
+func MultiEdgeAttribute[V comparable, N constraints.Number, T any](g *MultiGraph[V, N], id EdgeID, attr Attribute[T]) (T, bool) {
+	var value T
+	e, ok := g.edges[id]
+	if ok {
+		value, ok = e.attributes[attr.key()].(T)
+	}
+	return value, ok
+}
+
+// DeleteMultiEdgeAttribute removes the attribute of the edge of a MultiGraph with the
+// given ID. If the edge doesn't have the attribute, it is a no-op.
+func DeleteMultiEdgeAttribute[V comparable, N constraints.Number, T any](g *MultiGraph[V, N], id EdgeID, attr Attribute[T]) {
+	if e, ok := g.edges[id]; ok {
+		delete(e.attributes, attr.key())
+	}
+}
+
+// MultiEdgesWhere returns an iter.Seq2[EdgeID, T] over the IDs of the edges of a
+// MultiGraph whose attribute satisfies the predicate, along with the value of the
+// attribute, in increasing order of ID. Edges without the attribute are skipped. If
+// predicate is nil, every edge with the attribute is yielded.
+func MultiEdgesWhere[V comparable, N constraints.Number, T any](g *MultiGraph[V, N], attr Attribute[T], predicate func(T) bool) iter.Seq2[EdgeID, T] {
+	return func(yield func(EdgeID, T) bool) {
+		for _, id := range slices.Sorted(maps.Keys(g.edges)) {
+			value, ok := g.edges[id].attributes[attr.key()].(T)
+			if ok && (predicate == nil || predicate(value)) && !yield(id, value) {
+				return
+			}
+		}
+	}
+}
+
+// copyAttributes copies the attributes of the graph from to the vertices and edges of
+// the graph g that exist in g and have no attributes yet. If reverse is true, the
+// attributes of every edge are copied to the reversed edge. The values of the
+// attributes themselves are copied as is.
+func (g *HashGraph[V, N]) copyAttributes(from HashGraph[V, N], reverse bool) {
+	for v, attrs := range from.vertexAttrs {
+		if _, done := g.vertexAttrs[v]; done || !g.HasVertex(v) {
+			continue
+		}
+		if g.vertexAttrs == nil {
+			g.vertexAttrs = map[V]attributes{}
+		}
+		g.vertexAttrs[v] = maps.Clone(attrs)
+	}
+	for e, attrs := range from.edgeAttrs {
+		if reverse {
+			e[0], e[1] = e[1], e[0]
+		}
+		if _, done := g.edgeAttrs[e]; done || !g.HasEdge(e[0], e[1]) {
+			continue
+		}
+		if g.edgeAttrs == nil {
+			g.edgeAttrs = map[[2]V]attributes{}
+		}
+		clone := maps.Clone(attrs)
+		g.edgeAttrs[e] = clone
+		if !g.isDirected {
+			g.edgeAttrs[[2]V{e[1], e[0]}] = clone
+		}
+	}
+}
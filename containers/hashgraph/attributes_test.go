@@ -0,0 +1,151 @@
+package hashgraph_test
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/elordeiro/goext/containers/hashgraph"
+	"github.com/elordeiro/goext/containers/tuples"
+	"github.com/elordeiro/goext/seq2s"
+)
+
+var (
+	population = hashgraph.NewAttribute[int]("population")
+	capacity   = hashgraph.NewAttribute[float64]("capacity")
+	road       = hashgraph.NewAttribute[string]("road")
+)
+
+func TestVertexAttributes(t *testing.T) {
+	g := hashgraph.New[string, int](false)
+	g.AddEdge("a", "b")
+	g.AddVertex("c")
+
+	if err := hashgraph.SetVertexAttribute(g, "a", population, 120); err != nil {
+		t.Fatalf("SetVertexAttribute(a) error = %v", err)
+	}
+	hashgraph.SetVertexAttribute(g, "c", population, 40)
+	if err := hashgraph.SetVertexAttribute(g, "z", population, 1); err == nil {
+		t.Errorf("SetVertexAttribute(z) error = nil, want a VertexError")
+	}
+
+	if got, ok := hashgraph.VertexAttribute(g, "a", population); !ok || got != 120 {
+		t.Errorf("VertexAttribute(a) = %v, %v, want 120, true", got, ok)
+	}
+	if _, ok := hashgraph.VertexAttribute(g, "b", population); ok {
+		t.Errorf("VertexAttribute(b) = _, true, want false")
+	}
+	// the same name with another type does not match
+	if _, ok := hashgraph.VertexAttribute(g, "a", hashgraph.NewAttribute[string]("population")); ok {
+		t.Errorf("VertexAttribute(a) as a string = _, true, want false")
+	}
+
+	big := maps.Collect(hashgraph.VerticesWhere(g, population, func(p int) bool { return p > 100 }))
+	if len(big) != 1 || big["a"] != 120 {
+		t.Errorf("VerticesWhere(population > 100) = %v, want map[a:120]", big)
+	}
+	if got := seq2s.Len(hashgraph.VerticesWhere(g, population, nil)); got != 2 {
+		t.Errorf("len(VerticesWhere(population)) = %v, want 2", got)
+	}
+
+	hashgraph.DeleteVertexAttribute(g, "a", population)
+	g.RemoveVertex("c")
+	if got := seq2s.Len(hashgraph.VerticesWhere(g, population, nil)); got != 0 {
+		t.Errorf("len(VerticesWhere(population)) = %v after removing them, want 0", got)
+	}
+
+	// the same name with another type is another attribute
+	named := hashgraph.NewAttribute[string]("population")
+	hashgraph.SetVertexAttribute(g, "b", population, 5)
+	hashgraph.SetVertexAttribute(g, "b", named, "five")
+	if got, ok := hashgraph.VertexAttribute(g, "b", population); !ok || got != 5 {
+		t.Errorf("VertexAttribute(b) = %v, %v after setting it as a string, want 5, true", got, ok)
+	}
+	if got, ok := hashgraph.VertexAttribute(g, "b", named); !ok || got != "five" {
+		t.Errorf("VertexAttribute(b) as a string = %v, %v, want five, true", got, ok)
+	}
+}
+
+func TestEdgeAttributes(t *testing.T) {
+	g := hashgraph.New[int, int](false)
+	g.AddEdge(1, 2, 5)
+	g.AddEdge(2, 3, 7)
+
+	if err := hashgraph.SetEdgeAttribute(g, 1, 2, capacity, 2.5); err != nil {
+		t.Fatalf("SetEdgeAttribute(1, 2) error = %v", err)
+	}
+	hashgraph.SetEdgeAttribute(g, 3, 2, capacity, 0.5)
+	hashgraph.SetEdgeAttribute(g, 3, 2, road, "A1")
+	if err := hashgraph.SetEdgeAttribute(g, 1, 3, capacity, 1); err == nil {
+		t.Errorf("SetEdgeAttribute(1, 3) error = nil, want an EdgeError")
+	}
+
+	// undirected edges share their attributes
+	if got, ok := hashgraph.EdgeAttribute(g, 2, 1, capacity); !ok || got != 2.5 {
+		t.Errorf("EdgeAttribute(2, 1) = %v, %v, want 2.5, true", got, ok)
+	}
+	if got, ok := hashgraph.EdgeAttribute(g, 2, 3, road); !ok || got != "A1" {
+		t.Errorf("EdgeAttribute(2, 3) = %v, %v, want A1, true", got, ok)
+	}
+
+	wide := 0
+	for e, c := range hashgraph.EdgesWhere(g, capacity, func(c float64) bool { return c >= 1 }) {
+		if (e != tuples.NewEdge(1, 2, 5) && e != tuples.NewEdge(2, 1, 5)) || c != 2.5 {
+			t.Errorf("EdgesWhere(capacity >= 1) yielded %v, %v", e, c)
+		}
+		wide++
+	}
+	if wide != 1 {
+		t.Errorf("len(EdgesWhere(capacity >= 1)) = %v, want 1", wide)
+	}
+
+	// replacing the weight keeps the attributes, removing the edge drops them
+	g.AddEdge(1, 2, 6)
+	if _, ok := hashgraph.EdgeAttribute(g, 1, 2, capacity); !ok {
+		t.Errorf("AddEdge(1, 2) dropped the attributes of the edge")
+	}
+	g.RemoveEdge(2, 1)
+	g.AddEdge(1, 2)
+	if _, ok := hashgraph.EdgeAttribute(g, 1, 2, capacity); ok {
+		t.Errorf("RemoveEdge(2, 1) kept the attributes of the edge")
+	}
+}
+
+func TestAttributesCloneTranspose(t *testing.T) {
+	g := hashgraph.New[int, int](true)
+	g.AddEdge(1, 2)
+	g.AddVertex(3)
+	hashgraph.SetVertexAttribute(g, 3, population, 7)
+	hashgraph.SetEdgeAttribute(g, 1, 2, road, "M5")
+
+	clone := g.Clone()
+	if got, ok := hashgraph.VertexAttribute(clone, 3, population); !ok || got != 7 {
+		t.Errorf("Clone().VertexAttribute(3) = %v, %v, want 7, true", got, ok)
+	}
+	if got, ok := hashgraph.EdgeAttribute(clone, 1, 2, road); !ok || got != "M5" {
+		t.Errorf("Clone().EdgeAttribute(1, 2) = %v, %v, want M5, true", got, ok)
+	}
+	hashgraph.SetEdgeAttribute(clone, 1, 2, road, "M6")
+	if got, _ := hashgraph.EdgeAttribute(g, 1, 2, road); got != "M5" {
+		t.Errorf("EdgeAttribute(1, 2) = %v after changing the clone, want M5", got)
+	}
+
+	tr := g.Transpose()
+	if got, ok := hashgraph.EdgeAttribute(tr, 2, 1, road); !ok || got != "M5" {
+		t.Errorf("Transpose().EdgeAttribute(2, 1) = %v, %v, want M5, true", got, ok)
+	}
+	if _, ok := hashgraph.EdgeAttribute(tr, 1, 2, road); ok {
+		t.Errorf("Transpose().EdgeAttribute(1, 2) = _, true, want false")
+	}
+	if got, ok := hashgraph.VertexAttribute(tr, 3, population); !ok || got != 7 || !tr.HasVertex(3) {
+		t.Errorf("Transpose().VertexAttribute(3) = %v, %v, want 7, true", got, ok)
+	}
+
+	u := hashgraph.New[int, int](false)
+	u.AddEdge(1, 2)
+	hashgraph.SetEdgeAttribute(u, 1, 2, road, "B1")
+	uc := u.Clone()
+	hashgraph.SetEdgeAttribute(uc, 2, 1, road, "B2")
+	if got, _ := hashgraph.EdgeAttribute(uc, 1, 2, road); got != "B2" {
+		t.Errorf("Clone().EdgeAttribute(1, 2) = %v, want the attributes shared by both directions", got)
+	}
+}
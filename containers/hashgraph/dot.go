@@ -0,0 +1,429 @@
+package hashgraph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+	"unicode"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/tuples"
+)
+
+// dotOptions holds the configuration of WriteDOT
+type dotOptions[V comparable] struct {
+	vertexColors map[V]string
+	edgeColors   map[[2]V]string
+}
+
+// dotOption defines the signature of the functions that can be used to configure
+// WriteDOT
+type dotOption[V comparable] func(*dotOptions[V])
+
+// HighlightPathOption returns an option that highlights the vertices and edges of a
+// path, such as the output of graph.Dijkstra, in the DOT output. The default color is
+// red. When several paths are highlighted, the ones given later take precedence.
+func HighlightPathOption[V comparable, N constraints.Number](path iter.Seq[tuples.Edge[V, N]], color ...string) dotOption[V] {
+	c := "red"
+	if len(color) > 0 {
+		c = color[0]
+	}
+	return func(o *dotOptions[V]) {
+		for e := range path {
+			o.vertexColors[e.Src()] = c
+			o.vertexColors[e.Dst()] = c
+			o.edgeColors[[2]V{e.Src(), e.Dst()}] = c
+		}
+	}
+}
+
+// WriteDOT writes the graph to w in the Graphviz DOT language. Every vertex is written
+// as a node named after its fmt.Sprint representation, with a label attribute for
+// every label of the vertex, and every edge is written with its weight as its label.
+// The output is sorted, so the same graph is always written the same way.
+func (g HashGraph[V, N]) WriteDOT(w io.Writer, options ...dotOption[V]) error {
+	opts := dotOptions[V]{vertexColors: map[V]string{}, edgeColors: map[[2]V]string{}}
+	for _, opt := range options {
+		opt(&opts)
+	}
+	l := newLayout(g)
+
+	bw := bufio.NewWriter(w)
+	kind, op := "graph", "--"
+	if g.isDirected {
+		kind, op = "digraph", "->"
+	}
+	fmt.Fprintf(bw, "%s {\n", kind)
+	for _, v := range l.vertices {
+		color, highlighted := opts.vertexColors[v]
+		var attrs []string
+		if highlighted {
+			attrs = append(attrs, "color="+dotQuote(color))
+		}
+		if len(l.labels[v]) == 0 {
+			writeDOTStmt(bw, dotQuote(l.names[v]), attrs)
+		}
+		// a node statement per label, since DOT allows one label attribute per statement
+		for _, label := range l.labels[v] {
+			writeDOTStmt(bw, dotQuote(l.names[v]), append([]string{"label=" + dotQuote(label)}, attrs...))
+		}
+	}
+	for _, e := range l.edges(g) {
+		attrs := []string{"label=" + dotQuote(fmt.Sprint(e.Weight()))}
+		color, highlighted := opts.edgeColors[[2]V{e.Src(), e.Dst()}]
+		if !highlighted && !g.isDirected {
+			color, highlighted = opts.edgeColors[[2]V{e.Dst(), e.Src()}]
+		}
+		if highlighted {
+			attrs = append(attrs, "color="+dotQuote(color), "penwidth=2")
+		}
+		writeDOTStmt(bw, dotQuote(l.names[e.Src()])+" "+op+" "+dotQuote(l.names[e.Dst()]), attrs)
+	}
+	bw.WriteString("}\n")
+	return bw.Flush()
+}
+
+// writeDOTStmt writes a statement with its attribute list.
+func writeDOTStmt(w *bufio.Writer, stmt string, attrs []string) {
+	w.WriteString("\t" + stmt)
+	if len(attrs) > 0 {
+		w.WriteString(" [" + strings.Join(attrs, ", ") + "]")
+	}
+	w.WriteString(";\n")
+}
+
+// dotQuote returns s as a DOT quoted string.
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// ReadDOT reads a graph written in the Graphviz DOT language from r, using parse to
+// parse the name of every node into a vertex. The label attribute of a node is added
+// as a label of the vertex, and the label attribute of an edge, or else its weight
+// attribute, is parsed as the weight of the edge, which defaults to 1.
+// ReadDOT supports the node, edge and attribute statements of a single graph, with
+// chained edges such as a -> b -> c. Subgraphs are not supported and are reported as
+// a SyntaxError, as is any input that is not valid DOT.
+func ReadDOT[V comparable, N constraints.Number](r io.Reader, parse func(string) (V, error)) (*HashGraph[V, N], error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := dotTokens(string(data))
+	if err != nil {
+		return nil, err
+	}
+	p := dotParser[V, N]{tokens: tokens, parse: parse}
+	return p.graph()
+}
+
+// dotToken is a token of the DOT language. Quoted strings are unquoted, and are kept
+// apart from keywords and punctuation.
+type dotToken struct {
+	text   string
+	quoted bool
+	line   int
+}
+
+// dotTokens splits the DOT source src into tokens, skipping comments.
+func dotTokens(src string) ([]dotToken, error) {
+	var tokens []dotToken
+	line := 1
+	rs := []rune(src)
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		switch {
+		case r == '\n':
+			line++
+			i++
+		case unicode.IsSpace(r):
+			i++
+		case r == '#' && (i == 0 || rs[i-1] == '\n'), r == '/' && i+1 < len(rs) && rs[i+1] == '/':
+			for i < len(rs) && rs[i] != '\n' {
+				i++
+			}
+		case r == '/' && i+1 < len(rs) && rs[i+1] == '*':
+			i += 2
+			for i < len(rs) && !(rs[i] == '*' && i+1 < len(rs) && rs[i+1] == '/') {
+				if rs[i] == '\n' {
+					line++
+				}
+				i++
+			}
+			i += 2
+		case r == '"':
+			start := line
+			var sb strings.Builder
+			for i++; i < len(rs) && rs[i] != '"'; i++ {
+				switch {
+				case rs[i] == '\\' && i+1 < len(rs) && (rs[i+1] == '"' || rs[i+1] == '\\'):
+					i++
+					sb.WriteRune(rs[i])
+				case rs[i] == '\\' && i+1 < len(rs) && rs[i+1] == '\n':
+					i++
+					line++
+				default:
+					if rs[i] == '\n' {
+						line++
+					}
+					sb.WriteRune(rs[i])
+				}
+			}
+			if i == len(rs) {
+				return nil, SyntaxError{Format: "dot", Line: start, Msg: "unterminated string"}
+			}
+			i++
+			tokens = append(tokens, dotToken{sb.String(), true, start})
+		case r == '-' && i+1 < len(rs) && (rs[i+1] == '>' || rs[i+1] == '-'):
+			tokens = append(tokens, dotToken{string(rs[i : i+2]), false, line})
+			i += 2
+		case strings.ContainsRune("{}[];,=:", r):
+			tokens = append(tokens, dotToken{string(r), false, line})
+			i++
+		case r == '<':
+			// an HTML string, which ends at the matching >
+			start, depth := i, 0
+			for ; i < len(rs); i++ {
+				if rs[i] == '<' {
+					depth++
+				} else if rs[i] == '>' {
+					if depth--; depth == 0 {
+						break
+					}
+				} else if rs[i] == '\n' {
+					line++
+				}
+			}
+			if i == len(rs) {
+				return nil, SyntaxError{Format: "dot", Line: line, Msg: "unterminated HTML string"}
+			}
+			i++
+			tokens = append(tokens, dotToken{string(rs[start+1 : i-1]), true, line})
+		case isDOTIDRune(r):
+			start := i
+			for i < len(rs) && isDOTIDRune(rs[i]) && !(rs[i] == '-' && i+1 < len(rs) && (rs[i+1] == '>' || rs[i+1] == '-')) {
+				i++
+			}
+			tokens = append(tokens, dotToken{string(rs[start:i]), false, line})
+		default:
+			return nil, SyntaxError{Format: "dot", Line: line, Msg: fmt.Sprintf("unexpected character %q", r)}
+		}
+	}
+	return tokens, nil
+}
+
+// isDOTIDRune returns true if r can be part of an unquoted DOT identifier or numeral.
+func isDOTIDRune(r rune) bool {
+	return r == '_' || r == '.' || r == '-' || unicode.IsLetter(r) || unicode.IsDigit(r) || r > unicode.MaxASCII
+}
+
+// dotParser builds a graph from the tokens of a DOT graph.
+type dotParser[V comparable, N constraints.Number] struct {
+	tokens []dotToken
+	pos    int
+	parse  func(string) (V, error)
+	g      *HashGraph[V, N]
+}
+
+// peek returns the next token, or an empty token at the end of the input.
+func (p *dotParser[V, N]) peek() dotToken {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return dotToken{line: p.line()}
+}
+
+// line returns the line of the last token.
+func (p *dotParser[V, N]) line() int {
+	if len(p.tokens) == 0 {
+		return 1
+	}
+	return p.tokens[min(p.pos, len(p.tokens)-1)].line
+}
+
+// is returns true if the next token is the keyword or punctuation s.
+func (p *dotParser[V, N]) is(s string) bool {
+	t := p.peek()
+	return !t.quoted && strings.EqualFold(t.text, s) && p.pos < len(p.tokens)
+}
+
+// accept consumes the next token if it is the keyword or punctuation s.
+func (p *dotParser[V, N]) accept(s string) bool {
+	if p.is(s) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// expect consumes the next token, which must be the keyword or punctuation s.
+func (p *dotParser[V, N]) expect(s string) error {
+	if !p.accept(s) {
+		return p.errorf("expected %q, found %q", s, p.peek().text)
+	}
+	return nil
+}
+
+// id consumes the next token, which must be an identifier.
+func (p *dotParser[V, N]) id() (string, error) {
+	t := p.peek()
+	if p.pos == len(p.tokens) || (!t.quoted && len(t.text) == 1 && strings.Contains("{}[];,=:", t.text)) ||
+		(!t.quoted && (t.text == "->" || t.text == "--")) {
+		return "", p.errorf("expected an identifier, found %q", t.text)
+	}
+	p.pos++
+	return t.text, nil
+}
+
+func (p *dotParser[V, N]) errorf(format string, a ...any) error {
+	return SyntaxError{Format: "dot", Line: p.peek().line, Msg: fmt.Sprintf(format, a...)}
+}
+
+// graph parses a whole graph.
+func (p *dotParser[V, N]) graph() (*HashGraph[V, N], error) {
+	p.accept("strict")
+	switch {
+	case p.accept("digraph"):
+		p.g = New[V, N](true)
+	case p.accept("graph"):
+		p.g = New[V, N](false)
+	default:
+		return nil, p.errorf("expected \"graph\" or \"digraph\", found %q", p.peek().text)
+	}
+	if !p.is("{") {
+		if _, err := p.id(); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	for !p.accept("}") {
+		if p.pos == len(p.tokens) {
+			return nil, p.errorf("unexpected end of input")
+		}
+		if err := p.stmt(); err != nil {
+			return nil, err
+		}
+		p.accept(";")
+	}
+	if p.pos < len(p.tokens) {
+		return nil, p.errorf("unexpected %q after the graph", p.peek().text)
+	}
+	return p.g, nil
+}
+
+// stmt parses a single statement.
+func (p *dotParser[V, N]) stmt() error {
+	switch {
+	case p.is("subgraph") || p.is("{"):
+		return p.errorf("subgraphs are not supported")
+	case p.accept("graph"), p.accept("node"), p.accept("edge"):
+		_, err := p.attrs()
+		return err
+	}
+
+	first, err := p.id()
+	if err != nil {
+		return err
+	}
+	if p.accept("=") {
+		_, err := p.id()
+		return err
+	}
+	names := []string{first}
+	if err := p.port(); err != nil {
+		return err
+	}
+	for p.is("->") || p.is("--") {
+		if op := p.peek().text; (op == "->") != p.g.isDirected {
+			return p.errorf("edge operator %q does not match the graph", op)
+		}
+		p.pos++
+		if p.is("subgraph") || p.is("{") {
+			return p.errorf("subgraphs are not supported")
+		}
+		name, err := p.id()
+		if err != nil {
+			return err
+		}
+		if err := p.port(); err != nil {
+			return err
+		}
+		names = append(names, name)
+	}
+	line := p.peek().line
+	attrs, err := p.attrs()
+	if err != nil {
+		return err
+	}
+
+	vertices := make([]V, len(names))
+	for i, name := range names {
+		v, err := p.parse(name)
+		if err != nil {
+			return SyntaxError{Format: "dot", Line: line, Msg: fmt.Sprintf("invalid vertex %q", name), Err: err}
+		}
+		vertices[i] = v
+	}
+	if len(vertices) == 1 {
+		p.g.AddVertex(vertices[0])
+		if label, ok := attrs["label"]; ok {
+			p.g.AddLabel(vertices[0], label)
+		}
+		return nil
+	}
+
+	var w N = 1
+	s, ok := attrs["label"]
+	if !ok {
+		s, ok = attrs["weight"]
+	}
+	if ok {
+		if w, err = parseWeight[N](s); err != nil {
+			return SyntaxError{Format: "dot", Line: line, Msg: fmt.Sprintf("invalid weight %q", s), Err: err}
+		}
+	}
+	for i := 1; i < len(vertices); i++ {
+		p.g.AddEdge(vertices[i-1], vertices[i], w)
+	}
+	return nil
+}
+
+// port skips the port of a node, if any.
+func (p *dotParser[V, N]) port() error {
+	for range 2 {
+		if !p.accept(":") {
+			return nil
+		}
+		if _, err := p.id(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// attrs parses any number of attribute lists.
+func (p *dotParser[V, N]) attrs() (map[string]string, error) {
+	attrs := map[string]string{}
+	for p.accept("[") {
+		for !p.accept("]") {
+			key, err := p.id()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("="); err != nil {
+				return nil, err
+			}
+			if attrs[key], err = p.id(); err != nil {
+				return nil, err
+			}
+			if !p.accept(",") {
+				p.accept(";")
+			}
+		}
+	}
+	return attrs, nil
+}
@@ -0,0 +1,120 @@
+package hashgraph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/elordeiro/goext/constraints"
+)
+
+// WriteEdgeList writes the graph to w as a plain edge list, with one "src dst weight"
+// line per edge and one line for every vertex that has no edges. Vertices are written
+// with fmt.Sprint, so their representation must not contain whitespace. The list
+// starts with a "# directed" or "# undirected" line, followed by a "# label vertex
+// label" line for every label. The output is sorted, so the same graph is always
+// written the same way.
+func (g HashGraph[V, N]) WriteEdgeList(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	if g.isDirected {
+		bw.WriteString("# directed\n")
+	} else {
+		bw.WriteString("# undirected\n")
+	}
+
+	l := newLayout(g)
+	for _, v := range l.vertices {
+		for _, label := range l.labels[v] {
+			fmt.Fprintf(bw, "# label %s %s\n", l.names[v], label)
+		}
+	}
+	connected := map[V]bool{}
+	edges := l.edges(g)
+	for _, e := range edges {
+		connected[e.Src()], connected[e.Dst()] = true, true
+	}
+	for _, v := range l.vertices {
+		if !connected[v] {
+			fmt.Fprintf(bw, "%s\n", l.names[v])
+		}
+	}
+	for _, e := range edges {
+		fmt.Fprintf(bw, "%s %s %v\n", l.names[e.Src()], l.names[e.Dst()], e.Weight())
+	}
+	return bw.Flush()
+}
+
+// ReadEdgeList reads a graph written as a plain edge list from r, using parse to parse
+// every vertex. Every line holds either a single vertex, an unweighted edge "src dst"
+// or a weighted edge "src dst weight", separated by whitespace. Lines starting with #
+// are comments, except for the "# directed", "# undirected" and "# label vertex label"
+// lines written by WriteEdgeList, which must come before any vertex. Graphs without a
+// "# undirected" line are directed.
+func ReadEdgeList[V comparable, N constraints.Number](r io.Reader, parse func(string) (V, error)) (*HashGraph[V, N], error) {
+	g := New[V, N](true)
+	vertex := func(line int, s string) (V, error) {
+		v, err := parse(s)
+		if err != nil {
+			return v, SyntaxError{Format: "edge list", Line: line, Msg: fmt.Sprintf("invalid vertex %q", s), Err: err}
+		}
+		return v, nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if comment, ok := strings.CutPrefix(text, "#"); ok {
+			fields := strings.Fields(comment)
+			switch {
+			case len(fields) == 1 && (fields[0] == "directed" || fields[0] == "undirected"):
+				if g.VertexCount() > 0 {
+					return nil, SyntaxError{Format: "edge list", Line: line, Msg: fmt.Sprintf("%q after the first vertex", text)}
+				}
+				g.isDirected = fields[0] == "directed"
+			case len(fields) >= 3 && fields[0] == "label":
+				v, err := vertex(line, fields[1])
+				if err != nil {
+					return nil, err
+				}
+				_, rest, _ := strings.Cut(comment, "label")
+				_, label, _ := strings.Cut(strings.TrimSpace(rest), fields[1])
+				g.AddLabel(v, strings.TrimSpace(label))
+			}
+			continue
+		}
+
+		fields := strings.Fields(text)
+		switch len(fields) {
+		case 0:
+		case 1:
+			v, err := vertex(line, fields[0])
+			if err != nil {
+				return nil, err
+			}
+			g.AddVertex(v)
+		case 2, 3:
+			src, err := vertex(line, fields[0])
+			if err != nil {
+				return nil, err
+			}
+			dst, err := vertex(line, fields[1])
+			if err != nil {
+				return nil, err
+			}
+			var w N = 1
+			if len(fields) == 3 {
+				if w, err = parseWeight[N](fields[2]); err != nil {
+					return nil, SyntaxError{Format: "edge list", Line: line, Msg: fmt.Sprintf("invalid weight %q", fields[2]), Err: err}
+				}
+			}
+			g.AddEdge(src, dst, w)
+		default:
+			return nil, SyntaxError{Format: "edge list", Line: line, Msg: fmt.Sprintf("expected at most 3 fields, found %d", len(fields))}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
@@ -0,0 +1,100 @@
+package hashgraph
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/tuples"
+	extMath "github.com/elordeiro/goext/math"
+)
+
+// SyntaxError is returned when a graph cannot be decoded from its encoding.
+type SyntaxError struct {
+	Format string // the format being decoded, such as "dot"
+	Line   int    // the line of the input the error was found on, or 0 if unknown
+	Msg    string
+	Err    error // the error returned while parsing a vertex or weight, if any
+}
+
+func (e SyntaxError) Error() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("%s: line %d: %s", e.Format, e.Line, e.Msg)
+	}
+	return fmt.Sprintf("%s: %s", e.Format, e.Msg)
+}
+
+func (e SyntaxError) Unwrap() error {
+	return e.Err
+}
+
+// layout holds the vertices of a graph sorted by their string representation, so
+// that every encoding of the same graph is identical.
+type layout[V comparable, N constraints.Number] struct {
+	vertices []V
+	names    map[V]string
+	labels   map[V][]string
+}
+
+// newLayout returns the layout of the graph g.
+func newLayout[V comparable, N constraints.Number](g HashGraph[V, N]) layout[V, N] {
+	l := layout[V, N]{names: make(map[V]string, len(g.adjList)), labels: map[V][]string{}}
+	for v := range g.adjList {
+		l.vertices = append(l.vertices, v)
+		l.names[v] = fmt.Sprint(v)
+	}
+	slices.SortStableFunc(l.vertices, func(a, b V) int {
+		return cmp.Compare(l.names[a], l.names[b])
+	})
+	for label, v := range g.labels {
+		l.labels[v] = append(l.labels[v], label)
+	}
+	for _, labels := range l.labels {
+		slices.Sort(labels)
+	}
+	return l
+}
+
+// neighbors returns the edges leaving the vertex src sorted by their destination.
+func (l layout[V, N]) neighbors(g HashGraph[V, N], src V) []tuples.Edge[V, N] {
+	edges := make([]tuples.Edge[V, N], 0, len(g.adjList[src]))
+	for dst, w := range g.adjList[src] {
+		edges = append(edges, tuples.NewEdge(src, dst, w))
+	}
+	slices.SortStableFunc(edges, func(a, b tuples.Edge[V, N]) int {
+		return cmp.Compare(l.names[a.Dst()], l.names[b.Dst()])
+	})
+	return edges
+}
+
+// edges returns the edges of the graph g, sorted by their source and then by their
+// destination. In undirected graphs every edge is returned once.
+func (l layout[V, N]) edges(g HashGraph[V, N]) []tuples.Edge[V, N] {
+	var edges []tuples.Edge[V, N]
+	done := map[V]bool{}
+	for _, src := range l.vertices {
+		for _, e := range l.neighbors(g, src) {
+			if !g.isDirected && done[e.Dst()] {
+				continue
+			}
+			edges = append(edges, e)
+		}
+		done[src] = true
+	}
+	return edges
+}
+
+// parseWeight parses the string s as a weight of type N.
+func parseWeight[N constraints.Number](s string) (N, error) {
+	if extMath.IsIntegral[N]() {
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return N(i), nil
+		}
+		u, err := strconv.ParseUint(s, 10, 64)
+		return N(u), err
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	return N(f), err
+}
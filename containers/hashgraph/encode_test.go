@@ -0,0 +1,229 @@
+package hashgraph_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/elordeiro/goext/containers/hashgraph"
+	"github.com/elordeiro/goext/containers/tuples"
+)
+
+func labeledGraph(directed bool) *hashgraph.HashGraph[int, float64] {
+	g := hashgraph.New[int, float64](directed)
+	g.AddEdge(1, 2, 0.5)
+	g.AddEdge(2, 3, -2)
+	g.AddEdge(3, 3, 1)
+	g.AddVertex(10)
+	g.AddLabel(1, "start")
+	g.AddLabel(3, `the "end"`)
+	g.AddLabel(3, "goal")
+	return g
+}
+
+// sameGraph reports whether two graphs have the same directedness, vertices, weighted
+// edges and labels
+func sameGraph(g1, g2 *hashgraph.HashGraph[int, float64]) bool {
+	if g1.IsDirected() != g2.IsDirected() || g1.VertexCount() != g2.VertexCount() {
+		return false
+	}
+	for v := range g1.Vertices() {
+		if !g2.HasVertex(v) {
+			return false
+		}
+		for u, w := range g1.Neighbors(v) {
+			if !g2.HasEdge(v, u, w) {
+				return false
+			}
+		}
+	}
+	for e := range g2.Edges() {
+		if !g1.HasEdge(e.Src(), e.Dst(), e.Weight()) {
+			return false
+		}
+	}
+	labels := 0
+	for l, v := range g1.Labels() {
+		if u, err := g2.VertexByLabel(l); err != nil || u != v {
+			return false
+		}
+		labels++
+	}
+	for range g2.Labels() {
+		labels--
+	}
+	return labels == 0
+}
+
+func TestEncodings(t *testing.T) {
+	formats := []struct {
+		name  string
+		write func(g *hashgraph.HashGraph[int, float64], buf *bytes.Buffer) error
+		read  func(buf *bytes.Buffer) (*hashgraph.HashGraph[int, float64], error)
+	}{
+		{"DOT",
+			func(g *hashgraph.HashGraph[int, float64], buf *bytes.Buffer) error { return g.WriteDOT(buf) },
+			func(buf *bytes.Buffer) (*hashgraph.HashGraph[int, float64], error) {
+				return hashgraph.ReadDOT[int, float64](buf, strconv.Atoi)
+			}},
+		{"GraphML",
+			func(g *hashgraph.HashGraph[int, float64], buf *bytes.Buffer) error { return g.WriteGraphML(buf) },
+			func(buf *bytes.Buffer) (*hashgraph.HashGraph[int, float64], error) {
+				return hashgraph.ReadGraphML[int, float64](buf, strconv.Atoi)
+			}},
+		{"JSON",
+			func(g *hashgraph.HashGraph[int, float64], buf *bytes.Buffer) error { return g.WriteJSON(buf) },
+			func(buf *bytes.Buffer) (*hashgraph.HashGraph[int, float64], error) {
+				return hashgraph.ReadJSON[int, float64](buf)
+			}},
+		{"EdgeList",
+			func(g *hashgraph.HashGraph[int, float64], buf *bytes.Buffer) error { return g.WriteEdgeList(buf) },
+			func(buf *bytes.Buffer) (*hashgraph.HashGraph[int, float64], error) {
+				return hashgraph.ReadEdgeList[int, float64](buf, strconv.Atoi)
+			}},
+	}
+
+	for _, f := range formats {
+		for _, directed := range []bool{true, false} {
+			g := labeledGraph(directed)
+			var buf bytes.Buffer
+			if err := f.write(g, &buf); err != nil {
+				t.Fatalf("Write%s() error = %v", f.name, err)
+			}
+			encoded := buf.String()
+			got, err := f.read(&buf)
+			if err != nil {
+				t.Fatalf("Read%s() error = %v\n%s", f.name, err, encoded)
+			}
+			if !sameGraph(g, got) {
+				t.Errorf("Read%s(Write%s(%v)) = %v\n%s", f.name, f.name, g, got, encoded)
+			}
+
+			// the output is deterministic
+			var again bytes.Buffer
+			f.write(g, &again)
+			if again.String() != encoded {
+				t.Errorf("Write%s() is not deterministic", f.name)
+			}
+		}
+	}
+}
+
+func TestWriteDOT(t *testing.T) {
+	g := hashgraph.New[string, int](true)
+	g.AddEdge("a", "b", 2)
+	g.AddEdge("b", "c", 3)
+	g.AddEdge("a", "c", 7)
+	g.AddLabel("a", "start")
+
+	path := slices.Values([]tuples.Edge[string, int]{tuples.NewEdge("a", "b", 2), tuples.NewEdge("b", "c", 3)})
+	var buf bytes.Buffer
+	if err := g.WriteDOT(&buf, hashgraph.HighlightPathOption(path, "blue")); err != nil {
+		t.Fatalf("WriteDOT() error = %v", err)
+	}
+	want := `digraph {
+	"a" [label="start", color="blue"];
+	"b" [color="blue"];
+	"c" [color="blue"];
+	"a" -> "b" [label="2", color="blue", penwidth=2];
+	"a" -> "c" [label="7"];
+	"b" -> "c" [label="3", color="blue", penwidth=2];
+}
+`
+	if got := buf.String(); got != want {
+		t.Errorf("WriteDOT() = \n%v, want \n%v", got, want)
+	}
+}
+
+func TestReadDOT(t *testing.T) {
+	src := `/* a hand written graph */
+strict graph roads {
+	node [shape=circle]
+	a [label="Home"]; b
+	a -- b -- c [weight=4] // a chain
+	c:n -- d [label=-1.5, color=red]
+	e
+}`
+	g, err := hashgraph.ReadDOT[string, float64](strings.NewReader(src), func(s string) (string, error) { return s, nil })
+	if err != nil {
+		t.Fatalf("ReadDOT() error = %v", err)
+	}
+	if g.IsDirected() || g.VertexCount() != 5 {
+		t.Errorf("ReadDOT() = %v, want an undirected graph with 5 vertices", g)
+	}
+	if !g.HasEdge("b", "a", 4) || !g.HasEdge("c", "b", 4) || !g.HasEdge("d", "c", -1.5) {
+		t.Errorf("ReadDOT() = %v, want the weighted chain a b c d", g)
+	}
+	if v, err := g.VertexByLabel("Home"); err != nil || v != "a" {
+		t.Errorf("VertexByLabel(Home) = %v, %v, want a", v, err)
+	}
+
+	errs := []string{
+		`digraph { a -- b }`,
+		`graph { a -- }`,
+		`graph { subgraph s { a } }`,
+		`graph { "a }`,
+		`graph { a [label=x`,
+		`digraph { x -> y [label=heavy] }`,
+	}
+	for _, src := range errs {
+		_, err := hashgraph.ReadDOT[string, int](strings.NewReader(src), func(s string) (string, error) { return s, nil })
+		var syntaxErr hashgraph.SyntaxError
+		if !errors.As(err, &syntaxErr) {
+			t.Errorf("ReadDOT(%q) error = %v, want a SyntaxError", src, err)
+		}
+	}
+	_, err = hashgraph.ReadDOT[int, int](strings.NewReader(`graph { 1 -- x }`), strconv.Atoi)
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("ReadDOT() error = %v, want %v", err, strconv.ErrSyntax)
+	}
+}
+
+func TestReadEdgeList(t *testing.T) {
+	src := `# a SNAP style comment
+# undirected
+1 2
+2	3 5
+
+4
+`
+	g, err := hashgraph.ReadEdgeList[int, int](strings.NewReader(src), strconv.Atoi)
+	if err != nil {
+		t.Fatalf("ReadEdgeList() error = %v", err)
+	}
+	if g.IsDirected() || !g.HasEdge(2, 1, 1) || !g.HasEdge(3, 2, 5) || !g.HasVertex(4) {
+		t.Errorf("ReadEdgeList() = %v, want G[(1->2 1) (2->3 5)] and vertex 4", g)
+	}
+
+	for _, src := range []string{"1 2\n1 2 3 4\n", "1 2\n# directed\n", "1 2 x\n"} {
+		_, err = hashgraph.ReadEdgeList[int, int](strings.NewReader(src), strconv.Atoi)
+		var syntaxErr hashgraph.SyntaxError
+		if !errors.As(err, &syntaxErr) || syntaxErr.Line != strings.Count(src, "\n") {
+			t.Errorf("ReadEdgeList(%q) error = %v, want a SyntaxError on the last line", src, err)
+		}
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	g := hashgraph.New[string, int](false)
+	g.AddEdge("a", "b", 3)
+	g.AddLabel("a", "x")
+
+	data, err := json.Marshal(g)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	want := `{"directed":false,"vertices":[{"vertex":"a","labels":["x"],"edges":[{"to":"b","weight":3}]},{"vertex":"b","edges":[{"to":"a","weight":3}]}]}`
+	if string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+
+	var got hashgraph.HashGraph[string, int]
+	if err := json.Unmarshal(data, &got); err != nil || !got.HasEdge("b", "a", 3) || got.IsDirected() {
+		t.Errorf("Unmarshal() = %v, %v, want %v", &got, err, g)
+	}
+}
@@ -0,0 +1,169 @@
+package hashgraph
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	"github.com/elordeiro/goext/constraints"
+	extMath "github.com/elordeiro/goext/math"
+)
+
+// graphML is the root element of a GraphML document
+type graphML struct {
+	XMLName xml.Name     `xml:"graphml"`
+	Xmlns   string       `xml:"xmlns,attr,omitempty"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID      string  `xml:"id,attr"`
+	For     string  `xml:"for,attr"`
+	Name    string  `xml:"attr.name,attr"`
+	Type    string  `xml:"attr.type,attr"`
+	Default *string `xml:"default"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// WriteGraphML writes the graph to w as a GraphML document. Every vertex is written as
+// a node whose id is its fmt.Sprint representation, with a label data element for
+// every label of the vertex, and every edge is written with a weight data element.
+// The output is sorted, so the same graph is always written the same way.
+func (g HashGraph[V, N]) WriteGraphML(w io.Writer) error {
+	weightType := "double"
+	if extMath.IsIntegral[N]() {
+		weightType = "long"
+	}
+	doc := graphML{
+		Xmlns: "http://graphml.graphdrawing.org/xmlns",
+		Keys: []graphMLKey{
+			{ID: "label", For: "node", Name: "label", Type: "string"},
+			{ID: "weight", For: "edge", Name: "weight", Type: weightType},
+		},
+		Graph: graphMLGraph{ID: "G", EdgeDefault: "undirected"},
+	}
+	if g.isDirected {
+		doc.Graph.EdgeDefault = "directed"
+	}
+
+	l := newLayout(g)
+	for _, v := range l.vertices {
+		node := graphMLNode{ID: l.names[v]}
+		for _, label := range l.labels[v] {
+			node.Data = append(node.Data, graphMLData{Key: "label", Value: label})
+		}
+		doc.Graph.Nodes = append(doc.Graph.Nodes, node)
+	}
+	for _, e := range l.edges(g) {
+		doc.Graph.Edges = append(doc.Graph.Edges, graphMLEdge{
+			Source: l.names[e.Src()],
+			Target: l.names[e.Dst()],
+			Data:   []graphMLData{{Key: "weight", Value: fmt.Sprint(e.Weight())}},
+		})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// ReadGraphML reads a GraphML document from r, using parse to parse the id of every
+// node into a vertex. Node data whose key is named label are added as labels of the
+// vertex, and edge data whose key is named weight are parsed as the weight of the
+// edge, which defaults to the default of the key or else to 1. Only the first graph
+// of the document is read, and its edgedefault sets whether the graph is directed.
+func ReadGraphML[V comparable, N constraints.Number](r io.Reader, parse func(string) (V, error)) (*HashGraph[V, N], error) {
+	var doc graphML
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, SyntaxError{Format: "graphml", Msg: "invalid document", Err: err}
+	}
+
+	var labelKey, weightKey string
+	var w N = 1
+	for _, k := range doc.Keys {
+		switch {
+		case k.Name == "label" && (k.For == "node" || k.For == "all"):
+			labelKey = k.ID
+		case k.Name == "weight" && (k.For == "edge" || k.For == "all"):
+			weightKey = k.ID
+			if k.Default != nil {
+				d, err := parseWeight[N](*k.Default)
+				if err != nil {
+					return nil, SyntaxError{Format: "graphml", Msg: fmt.Sprintf("invalid weight %q", *k.Default), Err: err}
+				}
+				w = d
+			}
+		}
+	}
+
+	g := New[V, N](doc.Graph.EdgeDefault != "undirected")
+	vertex := func(id string) (V, error) {
+		v, err := parse(id)
+		if err != nil {
+			return v, SyntaxError{Format: "graphml", Msg: fmt.Sprintf("invalid vertex %q", id), Err: err}
+		}
+		return v, nil
+	}
+	for _, n := range doc.Graph.Nodes {
+		v, err := vertex(n.ID)
+		if err != nil {
+			return nil, err
+		}
+		g.AddVertex(v)
+		for _, d := range n.Data {
+			if d.Key == labelKey {
+				g.AddLabel(v, d.Value)
+			}
+		}
+	}
+	for _, e := range doc.Graph.Edges {
+		src, err := vertex(e.Source)
+		if err != nil {
+			return nil, err
+		}
+		dst, err := vertex(e.Target)
+		if err != nil {
+			return nil, err
+		}
+		weight := w
+		for _, d := range e.Data {
+			if d.Key == weightKey {
+				if weight, err = parseWeight[N](d.Value); err != nil {
+					return nil, SyntaxError{Format: "graphml", Msg: fmt.Sprintf("invalid weight %q", d.Value), Err: err}
+				}
+			}
+		}
+		g.AddEdge(src, dst, weight)
+	}
+	return g, nil
+}
@@ -32,11 +32,14 @@ func (e EdgeError[V, N]) Error() string {
 // vertices to a list of neighbors. The neighbor list itself is also a hashmap
 // that maps vertices to an number type allowing for the edges to be weighted.
 // For unweighted edges, the default weight is set to 1. The graph can be directed
-// or undirected. The graph also supports labels for vertices.
+// or undirected. The graph also supports labels for vertices, and typed attributes
+// for vertices and edges (see Attribute).
 type HashGraph[V comparable, N constraints.Number] struct {
-	adjList    map[V]map[V]N
-	labels     map[string]V
-	isDirected bool
+	adjList     map[V]map[V]N
+	labels      map[string]V
+	vertexAttrs map[V]attributes
+	edgeAttrs   map[[2]V]attributes
+	isDirected  bool
 }
 
 // New creates a new graph with the specified directedness.
@@ -57,6 +60,12 @@ func (g *HashGraph[V, N]) RemoveVertex(vertex V) {
 	for _, ns := range g.adjList {
 		delete(ns, vertex)
 	}
+	delete(g.vertexAttrs, vertex)
+	for e := range g.edgeAttrs {
+		if e[0] == vertex || e[1] == vertex {
+			delete(g.edgeAttrs, e)
+		}
+	}
 }
 
 // HasVertex returns true if the graph has the queried vertex and false otherwise.
@@ -95,8 +104,10 @@ func (g *HashGraph[V, N]) AddEdge(src, dst V, weight ...N) {
 // RemoveEdge removes an edge between two vertices. If the edge doesn't exist, it is a no-op.
 func (g *HashGraph[V, N]) RemoveEdge(src, dst V) {
 	delete(g.adjList[src], dst)
+	delete(g.edgeAttrs, [2]V{src, dst})
 	if !g.isDirected {
 		delete(g.adjList[dst], src)
+		delete(g.edgeAttrs, [2]V{dst, src})
 	}
 }
 
@@ -193,6 +204,18 @@ func (g HashGraph[V, N]) VertexByLabel(label string) (V, error) {
 	return v, nil
 }
 
+// Labels returns an iter.Seq2[string, V] over all the labels in the graph along with
+// the vertices they label.
+func (g HashGraph[V, N]) Labels() iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		for l, v := range g.labels {
+			if !yield(l, v) {
+				return
+			}
+		}
+	}
+}
+
 // VertexCount returns the number of vertices in the graph.
 func (g HashGraph[V, N]) VertexCount() int {
 	return len(g.adjList)
@@ -202,16 +225,21 @@ func (g HashGraph[V, N]) VertexCount() int {
 func (g *HashGraph[V, N]) Clear() {
 	clear(g.adjList)
 	clear(g.labels)
+	clear(g.vertexAttrs)
+	clear(g.edgeAttrs)
 }
 
-// Clone returns a deep copy of the graph.
+// Clone returns a deep copy of the graph, including the attributes of its vertices and
+// edges.
 func (g HashGraph[V, N]) Clone() *HashGraph[V, N] {
 	clone := New[V, N](g.isDirected)
 	for v, ns := range g.adjList {
+		clone.AddVertex(v)
 		for d, w := range ns {
 			clone.AddEdge(v, d, w)
 		}
 	}
+	clone.copyAttributes(g, false)
 	return clone
 }
 
@@ -219,17 +247,20 @@ func (g HashGraph[V, N]) Clone() *HashGraph[V, N] {
 // graph with all the edges reversed. For example, if there is an edge from
 // vertex A to vertex B in the original graph, there will be an edge from
 // vertex B to vertex A in the transpose graph. If the graph is undirected,
-// the transpose will be a clone of the original graph.
+// the transpose will be a clone of the original graph. The attributes of every edge
+// are kept by the reversed edge.
 func (g HashGraph[V, N]) Transpose() *HashGraph[V, N] {
 	if !g.IsDirected() {
 		return g.Clone()
 	}
 	newGragh := New[V, N](true)
 	for src := range g.Vertices() {
+		newGragh.AddVertex(src)
 		for dst, w := range g.Neighbors(src) {
 			newGragh.AddEdge(dst, src, w)
 		}
 	}
+	newGragh.copyAttributes(g, true)
 	return newGragh
 }
 
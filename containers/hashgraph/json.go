@@ -0,0 +1,80 @@
+package hashgraph
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/elordeiro/goext/constraints"
+)
+
+// jsonGraph is the JSON adjacency format of a graph
+type jsonGraph[V comparable, N constraints.Number] struct {
+	Directed bool               `json:"directed"`
+	Vertices []jsonVertex[V, N] `json:"vertices"`
+}
+
+type jsonVertex[V comparable, N constraints.Number] struct {
+	Vertex V                `json:"vertex"`
+	Labels []string         `json:"labels,omitempty"`
+	Edges  []jsonEdge[V, N] `json:"edges"`
+}
+
+type jsonEdge[V comparable, N constraints.Number] struct {
+	To     V `json:"to"`
+	Weight N `json:"weight"`
+}
+
+// MarshalJSON encodes the graph in a JSON adjacency format, which lists every vertex
+// along with its labels and the edges leaving it:
+//
+//	{"directed":true,"vertices":[{"vertex":1,"labels":["a"],"edges":[{"to":2,"weight":1}]}]}
+//
+// Vertices are encoded with encoding/json. In undirected graphs every edge is listed
+// by both of its ends. The output is sorted, so the same graph is always encoded the
+// same way.
+func (g HashGraph[V, N]) MarshalJSON() ([]byte, error) {
+	jg := jsonGraph[V, N]{Directed: g.isDirected, Vertices: []jsonVertex[V, N]{}}
+	l := newLayout(g)
+	for _, v := range l.vertices {
+		jv := jsonVertex[V, N]{Vertex: v, Labels: l.labels[v], Edges: []jsonEdge[V, N]{}}
+		for _, e := range l.neighbors(g, v) {
+			jv.Edges = append(jv.Edges, jsonEdge[V, N]{To: e.Dst(), Weight: e.Weight()})
+		}
+		jg.Vertices = append(jg.Vertices, jv)
+	}
+	return json.Marshal(jg)
+}
+
+// UnmarshalJSON decodes a graph in the JSON adjacency format of MarshalJSON, replacing
+// the contents of the graph.
+func (g *HashGraph[V, N]) UnmarshalJSON(data []byte) error {
+	var jg jsonGraph[V, N]
+	if err := json.Unmarshal(data, &jg); err != nil {
+		return err
+	}
+	*g = *New[V, N](jg.Directed)
+	for _, jv := range jg.Vertices {
+		g.AddVertex(jv.Vertex)
+		for _, label := range jv.Labels {
+			g.AddLabel(jv.Vertex, label)
+		}
+		for _, e := range jv.Edges {
+			g.AddEdge(jv.Vertex, e.To, e.Weight)
+		}
+	}
+	return nil
+}
+
+// WriteJSON writes the graph to w in the JSON adjacency format of MarshalJSON.
+func (g HashGraph[V, N]) WriteJSON(w io.Writer) error {
+	return json.NewEncoder(w).Encode(g)
+}
+
+// ReadJSON reads a graph in the JSON adjacency format of MarshalJSON from r.
+func ReadJSON[V comparable, N constraints.Number](r io.Reader) (*HashGraph[V, N], error) {
+	g := New[V, N](false)
+	if err := json.NewDecoder(r).Decode(g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
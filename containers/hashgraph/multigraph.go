@@ -0,0 +1,386 @@
+package hashgraph
+
+import (
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/tuples"
+)
+
+// EdgeID identifies an edge of a MultiGraph. IDs are assigned in increasing order and
+// are never reused by the same graph.
+type EdgeID int
+
+// EdgeIDError is returned when an edge ID is not found in the graph.
+type EdgeIDError struct {
+	id EdgeID
+}
+
+func (e EdgeIDError) Error() string {
+	return fmt.Sprintf("edge not found: #%d", e.id)
+}
+
+// multiEdge is an edge of a MultiGraph along with its attributes
+type multiEdge[V comparable, N constraints.Number] struct {
+	src, dst   V
+	weight     N
+	attributes attributes
+}
+
+// MultiGraph is a graph data structure that allows parallel edges, that is, more than
+// one edge between the same pair of vertices. Every edge has its own EdgeID, weight
+// and attributes (see Attribute and SetMultiEdgeAttribute). Like HashGraph, it is implemented using a hashmap that maps vertices
+// to their neighbors, where every neighbor maps to the IDs of the edges leading to it.
+// For unweighted edges, the default weight is set to 1. The graph can be directed or
+// undirected, and supports labels for vertices.
+// MultiGraph implements graph.Graph: Edges yields every parallel edge, while Neighbors
+// yields every neighbor once, along with the weight of the cheapest edge leading to
+// it, so the pathfinders always take the cheapest of the parallel edges.
+type MultiGraph[V comparable, N constraints.Number] struct {
+	adjList    map[V]map[V][]EdgeID
+	edges      map[EdgeID]*multiEdge[V, N]
+	nextID     EdgeID
+	labels     map[string]V
+	isDirected bool
+}
+
+// NewMulti creates a new multigraph with the specified directedness.
+func NewMulti[V comparable, N constraints.Number](isDirected bool) *MultiGraph[V, N] {
+	return &MultiGraph[V, N]{
+		adjList:    map[V]map[V][]EdgeID{},
+		edges:      map[EdgeID]*multiEdge[V, N]{},
+		labels:     map[string]V{},
+		isDirected: isDirected,
+	}
+}
+
+// AddVertex adds a vertex to the graph. If the vertex already exists, it is a no-op.
+func (g *MultiGraph[V, N]) AddVertex(vertex V) {
+	if _, ok := g.adjList[vertex]; !ok {
+		g.adjList[vertex] = map[V][]EdgeID{}
+	}
+}
+
+// RemoveVertex removes a vertex and all of its edges from the graph. If the vertex
+// doesn't exist, it is a no-op.
+func (g *MultiGraph[V, N]) RemoveVertex(vertex V) {
+	for id, e := range g.edges {
+		if e.src == vertex || e.dst == vertex {
+			g.RemoveEdge(id)
+		}
+	}
+	delete(g.adjList, vertex)
+}
+
+// HasVertex returns true if the graph has the queried vertex and false otherwise.
+func (g MultiGraph[V, N]) HasVertex(vertex V) bool {
+	_, ok := g.adjList[vertex]
+	return ok
+}
+
+// Degree returns the number of edges leaving a vertex in a directed graph and the
+// number of edges touching a vertex in an undirected graph, counting every parallel
+// edge. If the vertex doesn't exist, it returns a VertexError.
+func (g MultiGraph[V, N]) Degree(vertex V) (int, error) {
+	ns, ok := g.adjList[vertex]
+	if !ok {
+		return 0, VertexError[V]{vertex: vertex}
+	}
+	degree := 0
+	for _, ids := range ns {
+		degree += len(ids)
+	}
+	return degree, nil
+}
+
+// AddEdge adds a new edge between two vertices, src and dst, and returns its ID. If
+// either vertex doesn't exist, it is added to the graph. Edges already between src
+// and dst are kept. If no weight is provided, the default weight is set to 1.
+func (g *MultiGraph[V, N]) AddEdge(src, dst V, weight ...N) EdgeID {
+	var w N = 1
+	if weight != nil {
+		w = weight[0]
+	}
+	g.AddVertex(src)
+	g.AddVertex(dst)
+	id := g.nextID
+	g.nextID++
+	g.edges[id] = &multiEdge[V, N]{src: src, dst: dst, weight: w}
+	g.adjList[src][dst] = append(g.adjList[src][dst], id)
+	if !g.isDirected && src != dst {
+		g.adjList[dst][src] = append(g.adjList[dst][src], id)
+	}
+	return id
+}
+
+// RemoveEdge removes the edge with the given ID. If the edge doesn't exist, it is a
+// no-op.
+func (g *MultiGraph[V, N]) RemoveEdge(id EdgeID) {
+	e, ok := g.edges[id]
+	if !ok {
+		return
+	}
+	delete(g.edges, id)
+	g.unlink(e.src, e.dst, id)
+	if !g.isDirected {
+		g.unlink(e.dst, e.src, id)
+	}
+}
+
+// unlink removes the edge ID from the neighbor list of src.
+func (g *MultiGraph[V, N]) unlink(src, dst V, id EdgeID) {
+	ids := slices.DeleteFunc(g.adjList[src][dst], func(i EdgeID) bool { return i == id })
+	if len(ids) == 0 {
+		delete(g.adjList[src], dst)
+	} else {
+		g.adjList[src][dst] = ids
+	}
+}
+
+// RemoveEdges removes every edge between two vertices, src and dst. If there are no
+// such edges, it is a no-op.
+func (g *MultiGraph[V, N]) RemoveEdges(src, dst V) {
+	for _, id := range slices.Clone(g.adjList[src][dst]) {
+		g.RemoveEdge(id)
+	}
+}
+
+// HasEdge returns true if there is at least one edge between the 2 vertices. If a
+// weight is provided, it also checks that one of the edges has that weight.
+func (g MultiGraph[V, N]) HasEdge(src, dst V, weight ...N) bool {
+	ids := g.adjList[src][dst]
+	if len(weight) == 0 {
+		return len(ids) > 0
+	}
+	return slices.ContainsFunc(ids, func(id EdgeID) bool { return g.edges[id].weight == weight[0] })
+}
+
+// Edge returns the edge with the given ID. If the edge doesn't exist, it returns an
+// EdgeIDError.
+func (g MultiGraph[V, N]) Edge(id EdgeID) (tuples.Edge[V, N], error) {
+	e, ok := g.edges[id]
+	if !ok {
+		return tuples.Edge[V, N]{}, EdgeIDError{id: id}
+	}
+	return tuples.NewEdge(e.src, e.dst, e.weight), nil
+}
+
+// EdgeIDs returns an iter.Seq[EdgeID] over the IDs of every edge between two vertices,
+// src and dst, in the order they were added.
+func (g MultiGraph[V, N]) EdgeIDs(src, dst V) iter.Seq[EdgeID] {
+	return slices.Values(slices.Clone(g.adjList[src][dst]))
+}
+
+// EdgeWeight returns the weight of the cheapest edge between 2 vertices. If there is
+// no such edge, it returns an EdgeError.
+func (g MultiGraph[V, N]) EdgeWeight(src, dst V) (N, error) {
+	if _, w, ok := g.cheapest(src, dst); ok {
+		return w, nil
+	}
+	return 0, EdgeError[V, N]{src: src, dst: dst}
+}
+
+// cheapest returns the ID and the weight of the cheapest edge between src and dst.
+func (g MultiGraph[V, N]) cheapest(src, dst V) (EdgeID, N, bool) {
+	ids := g.adjList[src][dst]
+	if len(ids) == 0 {
+		return 0, 0, false
+	}
+	best := ids[0]
+	for _, id := range ids[1:] {
+		if g.edges[id].weight < g.edges[best].weight {
+			best = id
+		}
+	}
+	return best, g.edges[best].weight, true
+}
+
+// CheapestEdge returns the ID of the cheapest edge between 2 vertices, the first one
+// added in case of a tie. If there is no such edge, it returns an EdgeError.
+func (g MultiGraph[V, N]) CheapestEdge(src, dst V) (EdgeID, error) {
+	if id, _, ok := g.cheapest(src, dst); ok {
+		return id, nil
+	}
+	return 0, EdgeError[V, N]{src: src, dst: dst}
+}
+
+// SetEdgeWeight sets the weight of the edge with the given ID. If the edge doesn't
+// exist, it returns an EdgeIDError.
+func (g *MultiGraph[V, N]) SetEdgeWeight(id EdgeID, weight N) error {
+	e, ok := g.edges[id]
+	if !ok {
+		return EdgeIDError{id: id}
+	}
+	e.weight = weight
+	return nil
+}
+
+// AddLabel adds a label to a vertex. If the label already exists, it is updated.
+func (g *MultiGraph[V, N]) AddLabel(vertex V, label string) {
+	g.labels[label] = vertex
+}
+
+// VertexByLabel returns the vertex with the given label. If the vertex doesn't exist,
+// it returns a VertexError.
+func (g MultiGraph[V, N]) VertexByLabel(label string) (V, error) {
+	v, ok := g.labels[label]
+	if !ok {
+		return v, VertexError[string]{vertex: label}
+	}
+	return v, nil
+}
+
+// Labels returns an iter.Seq2[string, V] over all the labels in the graph along with
+// the vertices they label.
+func (g MultiGraph[V, N]) Labels() iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		for l, v := range g.labels {
+			if !yield(l, v) {
+				return
+			}
+		}
+	}
+}
+
+// VertexCount returns the number of vertices in the graph.
+func (g MultiGraph[V, N]) VertexCount() int {
+	return len(g.adjList)
+}
+
+// EdgeCount returns the number of edges in the graph, counting every parallel edge.
+func (g MultiGraph[V, N]) EdgeCount() int {
+	return len(g.edges)
+}
+
+// Clear removes all vertices and edges from the graph
+func (g *MultiGraph[V, N]) Clear() {
+	clear(g.adjList)
+	clear(g.edges)
+	clear(g.labels)
+}
+
+// Clone returns a deep copy of the graph, keeping the IDs and attributes of the edges.
+func (g MultiGraph[V, N]) Clone() *MultiGraph[V, N] {
+	clone := NewMulti[V, N](g.isDirected)
+	clone.nextID = g.nextID
+	for v, ns := range g.adjList {
+		clone.adjList[v] = make(map[V][]EdgeID, len(ns))
+		for d, ids := range ns {
+			clone.adjList[v][d] = slices.Clone(ids)
+		}
+	}
+	for id, e := range g.edges {
+		clone.edges[id] = &multiEdge[V, N]{src: e.src, dst: e.dst, weight: e.weight, attributes: maps.Clone(e.attributes)}
+	}
+	maps.Copy(clone.labels, g.labels)
+	return clone
+}
+
+// Transpose returns the transpose of the graph, with every edge reversed and keeping
+// its ID and attributes. If the graph is undirected, the transpose will be a clone of
+// the original graph.
+func (g MultiGraph[V, N]) Transpose() *MultiGraph[V, N] {
+	t := g.Clone()
+	if !g.isDirected {
+		return t
+	}
+	clear(t.adjList)
+	for v := range g.adjList {
+		t.adjList[v] = map[V][]EdgeID{}
+	}
+	for _, id := range slices.Sorted(maps.Keys(t.edges)) {
+		e := t.edges[id]
+		e.src, e.dst = e.dst, e.src
+		t.adjList[e.src][e.dst] = append(t.adjList[e.src][e.dst], id)
+	}
+	return t
+}
+
+// Simple returns a HashGraph with the same vertices and labels, and the cheapest of
+// every set of parallel edges.
+func (g MultiGraph[V, N]) Simple() *HashGraph[V, N] {
+	s := New[V, N](g.isDirected)
+	for v := range g.adjList {
+		s.AddVertex(v)
+		for d, w := range g.Neighbors(v) {
+			s.AddEdge(v, d, w)
+		}
+	}
+	maps.Copy(s.labels, g.labels)
+	return s
+}
+
+// IsDirected returns true if the graph is directed and false otherwise.
+func (g MultiGraph[V, N]) IsDirected() bool {
+	return g.isDirected
+}
+
+// EdgesByID returns an iter.Seq2[EdgeID, tuples.Edge[V, N]] over every edge in the
+// graph along with its ID, in the order they were added.
+func (g MultiGraph[V, N]) EdgesByID() iter.Seq2[EdgeID, tuples.Edge[V, N]] {
+	return func(yield func(EdgeID, tuples.Edge[V, N]) bool) {
+		for _, id := range slices.Sorted(maps.Keys(g.edges)) {
+			e := g.edges[id]
+			if !yield(id, tuples.NewEdge(e.src, e.dst, e.weight)) {
+				return
+			}
+		}
+	}
+}
+
+// Edges returns an iter.Seq[tuples.Edge[V, N]] over every edge in the graph, including
+// every parallel edge, in the order they were added.
+func (g MultiGraph[V, N]) Edges() iter.Seq[tuples.Edge[V, N]] {
+	return func(yield func(tuples.Edge[V, N]) bool) {
+		for _, e := range g.EdgesByID() {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// Vertices returns an iter.Seq[V] over all vertices in the graph.
+func (g MultiGraph[V, N]) Vertices() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for v := range g.adjList {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbors returns an iter.Seq2[V, N] over all the neighbors of a vertex. Every
+// neighbor is yielded once, along with the weight of the cheapest edge leading to it.
+func (g MultiGraph[V, N]) Neighbors(vertex V) iter.Seq2[V, N] {
+	return func(yield func(V, N) bool) {
+		for d := range g.adjList[vertex] {
+			_, w, _ := g.cheapest(vertex, d)
+			if !yield(d, w) {
+				return
+			}
+		}
+	}
+}
+
+// String returns a string representation of the graph.
+func (g MultiGraph[V, N]) String() string {
+	var sb strings.Builder
+	sb.WriteString("G[")
+	first := true
+	for e := range g.Edges() {
+		if first {
+			first = false
+		} else {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(e.String())
+	}
+	sb.WriteString("]")
+	return sb.String()
+}
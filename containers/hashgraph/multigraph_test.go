@@ -0,0 +1,160 @@
+package hashgraph_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/elordeiro/goext/containers/graph"
+	"github.com/elordeiro/goext/containers/hashgraph"
+	"github.com/elordeiro/goext/seq2s"
+	"github.com/elordeiro/goext/seqs"
+)
+
+func TestMultiGraph(t *testing.T) {
+	g := hashgraph.NewMulti[string, int](true)
+	train := g.AddEdge("a", "b", 5)
+	bus := g.AddEdge("a", "b", 3)
+	g.AddEdge("b", "c", 1)
+	g.AddEdge("a", "c", 10)
+
+	if got := g.EdgeCount(); got != 4 {
+		t.Errorf("EdgeCount() = %v, want 4", got)
+	}
+	if got := seqs.Len(g.Edges()); got != 4 {
+		t.Errorf("len(Edges()) = %v, want 4", got)
+	}
+	if got := slices.Collect(g.EdgeIDs("a", "b")); !slices.Equal(got, []hashgraph.EdgeID{train, bus}) {
+		t.Errorf("EdgeIDs(a, b) = %v, want [%v %v]", got, train, bus)
+	}
+	if w, err := g.EdgeWeight("a", "b"); err != nil || w != 3 {
+		t.Errorf("EdgeWeight(a, b) = %v, %v, want 3", w, err)
+	}
+	if id, err := g.CheapestEdge("a", "b"); err != nil || id != bus {
+		t.Errorf("CheapestEdge(a, b) = %v, %v, want %v", id, err, bus)
+	}
+	if !g.HasEdge("a", "b", 5) || g.HasEdge("a", "b", 4) || g.HasEdge("b", "a") {
+		t.Errorf("HasEdge() does not match the parallel edges")
+	}
+	if got, _ := g.Degree("a"); got != 3 {
+		t.Errorf("Degree(a) = %v, want 3", got)
+	}
+
+	// the pathfinders take the cheapest parallel edge
+	cost := 0
+	for e := range graph.Dijkstra(g, "a", graph.BaseCaseOption(func(v string) bool { return v == "c" })) {
+		cost += e.Weight()
+	}
+	if cost != 4 {
+		t.Errorf("Dijkstra(a, c) cost = %v, want 4", cost)
+	}
+
+	g.RemoveEdge(bus)
+	if w, _ := g.EdgeWeight("a", "b"); w != 5 {
+		t.Errorf("EdgeWeight(a, b) = %v after RemoveEdge(bus), want 5", w)
+	}
+	g.RemoveEdges("a", "b")
+	if g.HasEdge("a", "b") || g.EdgeCount() != 2 {
+		t.Errorf("RemoveEdges(a, b) = %v, want G[(b->c 1) (a->c 10)]", g)
+	}
+	if _, err := g.Edge(train); !errors.As(err, new(hashgraph.EdgeIDError)) {
+		t.Errorf("Edge(train) error = %v, want an EdgeIDError", err)
+	}
+}
+
+func TestMultiGraphAttributes(t *testing.T) {
+	line := hashgraph.NewAttribute[string]("line")
+	g := hashgraph.NewMulti[int, float64](false)
+	id := g.AddEdge(1, 2, 2.5)
+	other := g.AddEdge(1, 2, 4)
+	if err := hashgraph.SetMultiEdgeAttribute(g, id, line, "red"); err != nil {
+		t.Fatalf("SetMultiEdgeAttribute() error = %v", err)
+	}
+	if v, ok := hashgraph.MultiEdgeAttribute(g, id, line); !ok || v != "red" {
+		t.Errorf("MultiEdgeAttribute(line) = %v, %v, want red", v, ok)
+	}
+	if _, ok := hashgraph.MultiEdgeAttribute(g, other, line); ok {
+		t.Errorf("MultiEdgeAttribute(line) of a parallel edge = _, true, want false")
+	}
+	if _, ok := hashgraph.MultiEdgeAttribute(g, id, hashgraph.NewAttribute[string]("color")); ok {
+		t.Errorf("MultiEdgeAttribute(color) = _, true, want false")
+	}
+	if err := hashgraph.SetMultiEdgeAttribute(g, 42, line, "blue"); err == nil {
+		t.Errorf("SetMultiEdgeAttribute(42) error = nil, want an EdgeIDError")
+	}
+
+	hashgraph.SetMultiEdgeAttribute(g, other, line, "green")
+	if got := slices.Collect(seq2s.Keys(hashgraph.MultiEdgesWhere(g, line, nil))); !slices.Equal(got, []hashgraph.EdgeID{id, other}) {
+		t.Errorf("MultiEdgesWhere(line) = %v, want [%v %v]", got, id, other)
+	}
+	red := func(l string) bool { return l == "red" }
+	if got := slices.Collect(seq2s.Keys(hashgraph.MultiEdgesWhere(g, line, red))); !slices.Equal(got, []hashgraph.EdgeID{id}) {
+		t.Errorf("MultiEdgesWhere(line == red) = %v, want [%v]", got, id)
+	}
+	hashgraph.DeleteMultiEdgeAttribute(g, other, line)
+	if _, ok := hashgraph.MultiEdgeAttribute(g, other, line); ok {
+		t.Errorf("MultiEdgeAttribute(line) = _, true after deleting it, want false")
+	}
+
+	// clones keep the IDs and attributes of their edges, but not their maps
+	c := g.Clone()
+	hashgraph.SetMultiEdgeAttribute(c, id, line, "blue")
+	if v, _ := hashgraph.MultiEdgeAttribute(g, id, line); v != "red" {
+		t.Errorf("MultiEdgeAttribute(line) = %v after changing the clone, want red", v)
+	}
+	if next := c.AddEdge(1, 2); next == id {
+		t.Errorf("AddEdge() on the clone reused the ID %v", id)
+	}
+}
+
+func TestMultiGraphUndirected(t *testing.T) {
+	g := hashgraph.NewMulti[int, int](false)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 1)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 1)
+	loop := g.AddEdge(1, 1)
+
+	if got := slices.Collect(g.EdgeIDs(2, 1)); len(got) != 2 {
+		t.Errorf("EdgeIDs(2, 1) = %v, want 2 edges", got)
+	}
+	if got, _ := g.Degree(1); got != 4 {
+		t.Errorf("Degree(1) = %v, want 4", got)
+	}
+	// the parallel edges and the self-loop all belong to the circuit
+	if path, ok := graph.EulerianPath(g); !ok || seqs.Len(path) != 5 {
+		t.Errorf("EulerianPath() = %v, want a circuit of 5 edges", ok)
+	}
+
+	g.RemoveVertex(1)
+	if g.HasVertex(1) || g.EdgeCount() != 1 {
+		t.Errorf("RemoveVertex(1) = %v, want G[(2--3 1)]", g)
+	}
+	if _, err := g.Edge(loop); err == nil {
+		t.Errorf("Edge(loop) error = nil after RemoveVertex(1)")
+	}
+
+	s := hashgraph.NewMulti[int, int](false)
+	s.AddEdge(1, 2, 4)
+	s.AddEdge(1, 2, 2)
+	if simple := s.Simple(); !simple.HasEdge(2, 1, 2) || seqs.Len(simple.Edges()) != 1 {
+		t.Errorf("Simple() = %v, want G[(1->2 2)]", simple)
+	}
+}
+
+func TestMultiGraphTranspose(t *testing.T) {
+	g := hashgraph.NewMulti[int, int](true)
+	a := g.AddEdge(1, 2, 3)
+	g.AddEdge(1, 2, 4)
+
+	tr := g.Transpose()
+	if !tr.HasEdge(2, 1, 3) || !tr.HasEdge(2, 1, 4) || tr.HasEdge(1, 2) {
+		t.Errorf("Transpose() = %v, want G[(2->1 3) (2->1 4)]", tr)
+	}
+	if e, err := tr.Edge(a); err != nil || e.Src() != 2 || e.Dst() != 1 {
+		t.Errorf("Transpose().Edge(a) = %v, %v, want (2->1 3)", e, err)
+	}
+	if !g.HasEdge(1, 2) {
+		t.Errorf("Transpose() modified the original graph")
+	}
+}
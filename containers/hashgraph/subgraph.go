@@ -0,0 +1,219 @@
+package hashgraph
+
+import (
+	"iter"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/tuples"
+)
+
+// InducedSubgraph returns the subgraph induced by the vertices, that is, a graph with
+// the vertices and every edge of the graph between two of them. Vertices that are not
+// in the graph are ignored. The attributes of the vertices and edges are kept.
+func (g HashGraph[V, N]) InducedSubgraph(vertices iter.Seq[V]) *HashGraph[V, N] {
+	sub := New[V, N](g.isDirected)
+	for v := range vertices {
+		if g.HasVertex(v) {
+			sub.AddVertex(v)
+		}
+	}
+	for v := range sub.adjList {
+		for d, w := range g.adjList[v] {
+			if sub.HasVertex(d) {
+				sub.AddEdge(v, d, w)
+			}
+		}
+	}
+	sub.copyAttributes(g, false)
+	return sub
+}
+
+// EdgeSubgraph returns the subgraph with the edges of the graph that satisfy the
+// predicate, along with the vertices they connect. In undirected graphs the predicate
+// is called with every edge once. The attributes of the vertices and edges are kept.
+func (g HashGraph[V, N]) EdgeSubgraph(predicate func(edge tuples.Edge[V, N]) bool) *HashGraph[V, N] {
+	sub := New[V, N](g.isDirected)
+	for e := range g.Edges() {
+		if predicate(e) {
+			sub.AddEdge(e.Src(), e.Dst(), e.Weight())
+		}
+	}
+	sub.copyAttributes(g, false)
+	return sub
+}
+
+// checkDirectedness panics if the graphs g and other differ in directedness.
+func (g HashGraph[V, N]) checkDirectedness(other *HashGraph[V, N]) {
+	if g.isDirected != other.isDirected {
+		panic("graphs differ in directedness")
+	}
+}
+
+// Union returns a graph with the vertices and edges of both g and other. Edges in both
+// graphs keep the weight and the attributes they have in g. Union panics if the graphs
+// differ in directedness.
+func (g HashGraph[V, N]) Union(other *HashGraph[V, N]) *HashGraph[V, N] {
+	g.checkDirectedness(other)
+	union := New[V, N](g.isDirected)
+	for _, h := range []*HashGraph[V, N]{other, &g} {
+		for v, ns := range h.adjList {
+			union.AddVertex(v)
+			for d, w := range ns {
+				union.AddEdge(v, d, w)
+			}
+		}
+	}
+	union.copyAttributes(g, false)
+	union.copyAttributes(*other, false)
+	return union
+}
+
+// Intersection returns a graph with the vertices and edges that are in both g and
+// other. The edges keep the weight and the attributes they have in g. Intersection
+// panics if the graphs differ in directedness.
+func (g HashGraph[V, N]) Intersection(other *HashGraph[V, N]) *HashGraph[V, N] {
+	g.checkDirectedness(other)
+	inter := New[V, N](g.isDirected)
+	for v, ns := range g.adjList {
+		if !other.HasVertex(v) {
+			continue
+		}
+		inter.AddVertex(v)
+		for d, w := range ns {
+			if other.HasEdge(v, d) {
+				inter.AddEdge(v, d, w)
+			}
+		}
+	}
+	inter.copyAttributes(g, false)
+	return inter
+}
+
+// Difference returns a graph with the vertices of g and the edges of g that are not in
+// other. Difference panics if the graphs differ in directedness.
+func (g HashGraph[V, N]) Difference(other *HashGraph[V, N]) *HashGraph[V, N] {
+	g.checkDirectedness(other)
+	diff := New[V, N](g.isDirected)
+	for v, ns := range g.adjList {
+		diff.AddVertex(v)
+		for d, w := range ns {
+			if !other.HasEdge(v, d) {
+				diff.AddEdge(v, d, w)
+			}
+		}
+	}
+	diff.copyAttributes(g, false)
+	return diff
+}
+
+// Complement returns a graph with the vertices of g and an edge between every pair of
+// distinct vertices that are not adjacent in g. If no weight is provided, the default
+// weight is set to 1. The attributes of the vertices are kept.
+func (g HashGraph[V, N]) Complement(weight ...N) *HashGraph[V, N] {
+	comp := New[V, N](g.isDirected)
+	for v := range g.adjList {
+		comp.AddVertex(v)
+		for d := range g.adjList {
+			if v != d && !g.HasEdge(v, d) {
+				comp.AddEdge(v, d, weight...)
+			}
+		}
+	}
+	comp.copyAttributes(g, false)
+	return comp
+}
+
+// FilterView is a read-only view of a HashGraph that hides the vertices and edges that
+// do not satisfy its predicates, without copying the graph. It implements graph.Graph,
+// so algorithms can run on part of a graph, such as a network without its failed
+// links. The view reflects later changes to the underlying graph.
+type FilterView[V comparable, N constraints.Number] struct {
+	g      *HashGraph[V, N]
+	vertex func(V) bool
+	edge   func(tuples.Edge[V, N]) bool
+}
+
+// Filter returns a FilterView of the graph that shows the vertices that satisfy the
+// vertex predicate, and the edges between them that satisfy the edge predicate. A nil
+// predicate shows every vertex or every edge. In undirected graphs an edge is hidden
+// if the edge predicate rejects either of its directions.
+func (g *HashGraph[V, N]) Filter(vertex func(V) bool, edge func(tuples.Edge[V, N]) bool) *FilterView[V, N] {
+	if vertex == nil {
+		vertex = func(V) bool { return true }
+	}
+	if edge == nil {
+		edge = func(tuples.Edge[V, N]) bool { return true }
+	}
+	return &FilterView[V, N]{g: g, vertex: vertex, edge: edge}
+}
+
+// HasVertex returns true if the vertex is in the graph and is not hidden, and false
+// otherwise.
+func (f FilterView[V, N]) HasVertex(vertex V) bool {
+	return f.g.HasVertex(vertex) && f.vertex(vertex)
+}
+
+// visible returns true if the edge from src to dst with weight w is not hidden.
+func (f FilterView[V, N]) visible(src, dst V, w N) bool {
+	return f.vertex(dst) && f.edge(tuples.NewEdge(src, dst, w)) &&
+		(f.g.isDirected || f.edge(tuples.NewEdge(dst, src, w)))
+}
+
+// HasEdge returns true if there is an edge between the 2 vertices that is not hidden.
+// If a weight is provided, it also checks that the edge has that weight.
+func (f FilterView[V, N]) HasEdge(src, dst V, weight ...N) bool {
+	w, err := f.g.EdgeWeight(src, dst)
+	return err == nil && (len(weight) == 0 || w == weight[0]) && f.vertex(src) && f.visible(src, dst, w)
+}
+
+// IsDirected returns true if the graph is directed and false otherwise.
+func (f FilterView[V, N]) IsDirected() bool {
+	return f.g.isDirected
+}
+
+// VertexCount returns the number of vertices that are not hidden. It checks every
+// vertex of the graph.
+func (f FilterView[V, N]) VertexCount() int {
+	count := 0
+	for range f.Vertices() {
+		count++
+	}
+	return count
+}
+
+// Vertices returns an iter.Seq[V] over the vertices that are not hidden.
+func (f FilterView[V, N]) Vertices() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for v := range f.g.adjList {
+			if f.vertex(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Edges returns an iter.Seq[tuples.Edge[V, N]] over the edges that are not hidden.
+func (f FilterView[V, N]) Edges() iter.Seq[tuples.Edge[V, N]] {
+	return func(yield func(tuples.Edge[V, N]) bool) {
+		for e := range f.g.Edges() {
+			if f.vertex(e.Src()) && f.visible(e.Src(), e.Dst(), e.Weight()) && !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbors returns an iter.Seq2[V, N] over the neighbors of a vertex that are reached
+// by edges that are not hidden. If the vertex is hidden, an empty sequence is returned.
+func (f FilterView[V, N]) Neighbors(vertex V) iter.Seq2[V, N] {
+	return func(yield func(V, N) bool) {
+		if !f.vertex(vertex) {
+			return
+		}
+		for d, w := range f.g.adjList[vertex] {
+			if f.visible(vertex, d, w) && !yield(d, w) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package hashgraph_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/elordeiro/goext/containers/graph"
+	"github.com/elordeiro/goext/containers/hashgraph"
+	"github.com/elordeiro/goext/containers/tuples"
+	"github.com/elordeiro/goext/seqs"
+)
+
+func TestInducedSubgraph(t *testing.T) {
+	g := graphTest(false)
+	hashgraph.SetEdgeAttribute(g, 1, 2, road, "A1")
+	hashgraph.SetVertexAttribute(g, 4, population, 10)
+
+	sub := g.InducedSubgraph(slices.Values([]int{1, 2, 4, 6, 99}))
+	if got := sub.VertexCount(); got != 4 {
+		t.Errorf("VertexCount() = %v, want 4", got)
+	}
+	if got := seqs.Len(sub.Edges()); got != 2 || !sub.HasEdge(2, 1) || !sub.HasEdge(4, 2) {
+		t.Errorf("InducedSubgraph() = %v, want G[(1--2 1) (2--4 1)]", sub)
+	}
+	if got, ok := hashgraph.EdgeAttribute(sub, 2, 1, road); !ok || got != "A1" {
+		t.Errorf("EdgeAttribute(2, 1) = %v, %v, want A1, true", got, ok)
+	}
+	if got, ok := hashgraph.VertexAttribute(sub, 4, population); !ok || got != 10 {
+		t.Errorf("VertexAttribute(4) = %v, %v, want 10, true", got, ok)
+	}
+}
+
+func TestEdgeSubgraph(t *testing.T) {
+	g := hashgraph.New[int, int](true)
+	g.AddEdge(1, 2, 5)
+	g.AddEdge(2, 3, 1)
+	g.AddEdge(3, 4, 7)
+	g.AddVertex(5)
+
+	sub := g.EdgeSubgraph(func(e tuples.Edge[int, int]) bool { return e.Weight() > 2 })
+	if sub.VertexCount() != 4 || !sub.HasEdge(1, 2) || !sub.HasEdge(3, 4) || sub.HasEdge(2, 3) {
+		t.Errorf("EdgeSubgraph(weight > 2) = %v, want G[(1->2 5) (3->4 7)]", sub)
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	g1 := hashgraph.New[int, int](false)
+	g1.AddEdge(1, 2, 1)
+	g1.AddEdge(2, 3, 2)
+	g1.AddVertex(7)
+	g2 := hashgraph.New[int, int](false)
+	g2.AddEdge(2, 1, 10)
+	g2.AddEdge(3, 4, 3)
+
+	union := g1.Union(g2)
+	if union.VertexCount() != 5 || seqs.Len(union.Edges()) != 3 || !union.HasEdge(1, 2, 1) || !union.HasEdge(4, 3, 3) {
+		t.Errorf("Union() = %v, want the edges of both graphs with the weights of g1", union)
+	}
+
+	inter := g1.Intersection(g2)
+	if inter.VertexCount() != 3 || seqs.Len(inter.Edges()) != 1 || !inter.HasEdge(2, 1, 1) {
+		t.Errorf("Intersection() = %v, want G[(1--2 1)] over the vertices 1, 2 and 3", inter)
+	}
+
+	diff := g1.Difference(g2)
+	if diff.VertexCount() != 4 || seqs.Len(diff.Edges()) != 1 || !diff.HasEdge(3, 2, 2) {
+		t.Errorf("Difference() = %v, want G[(2--3 2)] over the vertices of g1", diff)
+	}
+
+	comp := g1.Complement()
+	want := [][2]int{{1, 3}, {1, 7}, {2, 7}, {3, 7}}
+	if got := seqs.Len(comp.Edges()); got != len(want) {
+		t.Errorf("len(Complement().Edges()) = %v, want %v", got, len(want))
+	}
+	for _, e := range want {
+		if !comp.HasEdge(e[0], e[1], 1) {
+			t.Errorf("Complement() = %v, missing %v", comp, e)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Union() of a directed and an undirected graph did not panic")
+		}
+	}()
+	g1.Union(hashgraph.New[int, int](true))
+}
+
+func TestFilterView(t *testing.T) {
+	g := hashgraph.New[string, int](false)
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("b", "d", 1)
+	g.AddEdge("a", "c", 2)
+	g.AddEdge("c", "d", 2)
+	g.AddEdge("a", "d", 10)
+	g.AddVertex("x")
+
+	failed := map[[2]string]bool{{"b", "d"}: true}
+	view := g.Filter(
+		func(v string) bool { return v != "x" },
+		func(e tuples.Edge[string, int]) bool { return !failed[[2]string{e.Src(), e.Dst()}] },
+	)
+
+	if view.VertexCount() != 4 || view.HasVertex("x") {
+		t.Errorf("VertexCount() = %v, want 4 without x", view.VertexCount())
+	}
+	// the link is hidden in both directions of the undirected graph
+	if view.HasEdge("b", "d") || view.HasEdge("d", "b") || !view.HasEdge("d", "c", 2) {
+		t.Errorf("HasEdge() does not hide the failed link")
+	}
+	if got := seqs.Len(view.Edges()); got != 4 {
+		t.Errorf("len(Edges()) = %v, want 4", got)
+	}
+
+	var _ graph.Graph[string, int] = view
+	cost := 0
+	for e := range graph.Dijkstra(view, "a", graph.BaseCaseOption(func(v string) bool { return v == "d" })) {
+		cost += e.Weight()
+	}
+	if cost != 4 {
+		t.Errorf("Dijkstra(a, d) cost = %v, want 4", cost)
+	}
+
+	// the view follows the graph without copying it
+	delete(failed, [2]string{"b", "d"})
+	g.RemoveEdge("c", "d")
+	if !view.HasEdge("d", "b") || view.HasEdge("c", "d") {
+		t.Errorf("the view does not reflect changes to the graph")
+	}
+	if got := seqs.Len(g.Filter(nil, nil).Edges()); got != seqs.Len(g.Edges()) {
+		t.Errorf("len(Filter(nil, nil).Edges()) = %v, want %v", got, seqs.Len(g.Edges()))
+	}
+}
@@ -0,0 +1,270 @@
+package hashgraph
+
+import (
+	"iter"
+	"slices"
+	"sync"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/tuples"
+)
+
+// SyncHashGraph is a HashGraph that is safe for concurrent use by multiple goroutines.
+// Every method holds a sync.RWMutex, so any number of readers can query the graph at
+// once while writers get exclusive access. The iterators returned by Edges, Vertices,
+// Neighbors and Labels range over a snapshot taken when the iteration starts, so the
+// graph can be modified while they are ranged over, including from the loop body.
+// Use Update or View to run several operations atomically.
+type SyncHashGraph[V comparable, N constraints.Number] struct {
+	mu sync.RWMutex
+	g  *HashGraph[V, N]
+}
+
+// NewSync creates a new concurrency-safe graph with the specified directedness.
+func NewSync[V comparable, N constraints.Number](isDirected bool) *SyncHashGraph[V, N] {
+	return &SyncHashGraph[V, N]{g: New[V, N](isDirected)}
+}
+
+// Update calls fn with the underlying graph while holding the write lock, so fn can
+// run several operations atomically. The graph must not be used after fn returns.
+func (s *SyncHashGraph[V, N]) Update(fn func(g *HashGraph[V, N])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(s.g)
+}
+
+// View calls fn with the underlying graph while holding the read lock, so fn can run
+// several queries against the same state of the graph. fn must not modify the graph,
+// and the graph must not be used after fn returns.
+func (s *SyncHashGraph[V, N]) View(fn func(g *HashGraph[V, N])) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	fn(s.g)
+}
+
+// Snapshot returns a deep copy of the graph as a HashGraph.
+func (s *SyncHashGraph[V, N]) Snapshot() *HashGraph[V, N] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.g.Clone()
+}
+
+// AddVertex adds a vertex to the graph. If the vertex already exists, it is a no-op.
+func (s *SyncHashGraph[V, N]) AddVertex(vertex V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.g.AddVertex(vertex)
+}
+
+// RemoveVertex removes a vertex from the graph. If the vertex doesn't exist, it is a no-op.
+func (s *SyncHashGraph[V, N]) RemoveVertex(vertex V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.g.RemoveVertex(vertex)
+}
+
+// HasVertex returns true if the graph has the queried vertex and false otherwise.
+func (s *SyncHashGraph[V, N]) HasVertex(vertex V) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.g.HasVertex(vertex)
+}
+
+// Degree returns the out-degree of a vertex in a directed graph and the degree
+// of a vertex in an undirected graph. If the vertex doesn't exist, it returns
+// a VertexError.
+func (s *SyncHashGraph[V, N]) Degree(vertex V) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.g.Degree(vertex)
+}
+
+// AddEdge adds an edge between two vertices, src and dst. If the either vertex
+// doesn't exist, it is added to the graph. If the graph is undirected, the edge
+// is added in both directions. If the edge already exists, the weight is updated.
+// If no weight is provided, the default weight is set to 1.
+func (s *SyncHashGraph[V, N]) AddEdge(src, dst V, weight ...N) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.g.AddEdge(src, dst, weight...)
+}
+
+// RemoveEdge removes an edge between two vertices. If the edge doesn't exist, it is a no-op.
+func (s *SyncHashGraph[V, N]) RemoveEdge(src, dst V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.g.RemoveEdge(src, dst)
+}
+
+// HasEdge returns true if there is an edge between the 2 vertices. If a weight is
+// provided, it also checks that the edge has that weight.
+func (s *SyncHashGraph[V, N]) HasEdge(src, dst V, weight ...N) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.g.HasEdge(src, dst, weight...)
+}
+
+// Edge returns the edge between two vertices. If the edge doesn't exist, it returns
+// an EdgeError.
+func (s *SyncHashGraph[V, N]) Edge(src, dst V) (tuples.Edge[V, N], error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.g.Edge(src, dst)
+}
+
+// EdgeWeight returns the weight of an edge between 2 vertices. If the edge doesn't
+// exist, it returns an EdgeError.
+func (s *SyncHashGraph[V, N]) EdgeWeight(src, dst V) (N, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.g.EdgeWeight(src, dst)
+}
+
+// SetEdgeWeight sets the weight of an edge. If either vertex doesn't exist
+// it is a no-op.
+func (s *SyncHashGraph[V, N]) SetEdgeWeight(src, dst V, weight N) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.g.SetEdgeWeight(src, dst, weight)
+}
+
+// AddLabel adds a label to a vertex. If the label already exists, it is updated.
+func (s *SyncHashGraph[V, N]) AddLabel(vertex V, label string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.g.AddLabel(vertex, label)
+}
+
+// AddEdgeByLabel adds an edge between two vertices using their labels. If either
+// vertex doesn't exist, it returns a VertexError.
+func (s *SyncHashGraph[V, N]) AddEdgeByLabel(src, dst string, weight ...N) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.g.AddEdgeByLabel(src, dst, weight...)
+}
+
+// EdgeByLabel returns the edge between two vertices using their labels. If either
+// vertex doesn't exist, it returns a VertexError.
+func (s *SyncHashGraph[V, N]) EdgeByLabel(src, dst string) (tuples.Edge[V, N], error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.g.EdgeByLabel(src, dst)
+}
+
+// VertexByLabel returns the vertex with the given label. If the vertex doesn't exist,
+// it returns a VertexError.
+func (s *SyncHashGraph[V, N]) VertexByLabel(label string) (V, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.g.VertexByLabel(label)
+}
+
+// VertexCount returns the number of vertices in the graph.
+func (s *SyncHashGraph[V, N]) VertexCount() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.g.VertexCount()
+}
+
+// Clear removes all vertices and edges from the graph
+func (s *SyncHashGraph[V, N]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.g.Clear()
+}
+
+// Clone returns a deep copy of the graph.
+func (s *SyncHashGraph[V, N]) Clone() *SyncHashGraph[V, N] {
+	return &SyncHashGraph[V, N]{g: s.Snapshot()}
+}
+
+// Transpose returns the transpose of the graph. If the graph is undirected, the
+// transpose will be a clone of the original graph.
+func (s *SyncHashGraph[V, N]) Transpose() *SyncHashGraph[V, N] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return &SyncHashGraph[V, N]{g: s.g.Transpose()}
+}
+
+// IsDirected returns true if the graph is directed and false otherwise.
+func (s *SyncHashGraph[V, N]) IsDirected() bool {
+	// the directedness never changes, so it can be read without the lock
+	return s.g.isDirected
+}
+
+// snapshot returns an iterator over the elements collected by collect, which is
+// called with the read lock held every time the iteration starts.
+func snapshot[T any](mu *sync.RWMutex, collect func() []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		mu.RLock()
+		items := collect()
+		mu.RUnlock()
+		for _, item := range items {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Edges returns an iter.Seq[tuples.Edge[V, N]] over a snapshot of all the edges in
+// the graph, taken when the iteration starts.
+func (s *SyncHashGraph[V, N]) Edges() iter.Seq[tuples.Edge[V, N]] {
+	return snapshot(&s.mu, func() []tuples.Edge[V, N] {
+		return slices.Collect(s.g.Edges())
+	})
+}
+
+// Vertices returns an iter.Seq[V] over a snapshot of all the vertices in the graph,
+// taken when the iteration starts.
+func (s *SyncHashGraph[V, N]) Vertices() iter.Seq[V] {
+	return snapshot(&s.mu, func() []V {
+		return slices.Collect(s.g.Vertices())
+	})
+}
+
+// Neighbors returns an iter.Seq2[V, N] over a snapshot of the neighbors of a vertex,
+// taken when the iteration starts. The first returned value is the destination, and
+// the second returned value is the edge weight.
+func (s *SyncHashGraph[V, N]) Neighbors(vertex V) iter.Seq2[V, N] {
+	neighbors := snapshot(&s.mu, func() []tuples.Pair[V, N] {
+		var ns []tuples.Pair[V, N]
+		for d, w := range s.g.Neighbors(vertex) {
+			ns = append(ns, tuples.NewPair(d, w))
+		}
+		return ns
+	})
+	return func(yield func(V, N) bool) {
+		for p := range neighbors {
+			if !yield(p.Left(), p.Right()) {
+				return
+			}
+		}
+	}
+}
+
+// Labels returns an iter.Seq2[string, V] over a snapshot of all the labels in the
+// graph along with the vertices they label, taken when the iteration starts.
+func (s *SyncHashGraph[V, N]) Labels() iter.Seq2[string, V] {
+	labels := snapshot(&s.mu, func() []tuples.Pair[string, V] {
+		var ls []tuples.Pair[string, V]
+		for l, v := range s.g.Labels() {
+			ls = append(ls, tuples.NewPair(l, v))
+		}
+		return ls
+	})
+	return func(yield func(string, V) bool) {
+		for p := range labels {
+			if !yield(p.Left(), p.Right()) {
+				return
+			}
+		}
+	}
+}
+
+// String returns a string representation of the graph.
+func (s *SyncHashGraph[V, N]) String() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.g.String()
+}
@@ -0,0 +1,114 @@
+package hashgraph_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/elordeiro/goext/containers/graph"
+	"github.com/elordeiro/goext/containers/hashgraph"
+	"github.com/elordeiro/goext/seqs"
+)
+
+func TestSyncHashGraphConcurrentWrites(t *testing.T) {
+	g := hashgraph.NewSync[int, int](true)
+	const workers, edges = 8, 200
+
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range edges {
+				g.AddEdge(w*edges+i, w*edges+i+1, i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := seqs.Len(g.Edges()); got != workers*edges {
+		t.Errorf("len(Edges()) = %v, want %v", got, workers*edges)
+	}
+	if got := g.VertexCount(); got != workers*edges+1 {
+		t.Errorf("VertexCount() = %v, want %v", got, workers*edges+1)
+	}
+}
+
+func TestSyncHashGraphReadsDuringWrites(t *testing.T) {
+	g := hashgraph.NewSync[int, int](false)
+	for v := range 100 {
+		g.AddEdge(v, v+1)
+	}
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; ; i++ {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			g.AddEdge(i%100, 100+i%50, i)
+			g.RemoveEdge(i%100, 100+(i+25)%50)
+			g.AddLabel(i%100, "hot")
+		}
+	}()
+
+	for range 50 {
+		for v := range g.Vertices() {
+			for d := range g.Neighbors(v) {
+				g.HasEdge(v, d)
+			}
+		}
+		for range g.Edges() {
+		}
+		for range g.Labels() {
+		}
+		for range graph.BFS(g, 0) {
+		}
+		g.Degree(0)
+		_ = g.String()
+	}
+	close(stop)
+	wg.Wait()
+}
+
+func TestSyncHashGraphMutateWhileIterating(t *testing.T) {
+	g := hashgraph.NewSync[int, int](true)
+	g.AddEdge(1, 2)
+	g.AddEdge(1, 3)
+
+	// the snapshot is not affected by changes made from the loop body
+	seen := 0
+	for d := range g.Neighbors(1) {
+		g.RemoveEdge(1, d)
+		g.AddEdge(1, d+10)
+		seen++
+	}
+	if seen != 2 {
+		t.Errorf("Neighbors(1) yielded %v neighbors, want 2", seen)
+	}
+	if !g.HasEdge(1, 12) || !g.HasEdge(1, 13) || g.HasEdge(1, 2) {
+		t.Errorf("graph = %v, want G[(1->12 1) (1->13 1)]", g)
+	}
+
+	g.Update(func(h *hashgraph.HashGraph[int, int]) {
+		h.AddEdge(2, 3, 4)
+		h.SetEdgeWeight(2, 3, 5)
+	})
+	var w int
+	g.View(func(h *hashgraph.HashGraph[int, int]) {
+		w, _ = h.EdgeWeight(2, 3)
+	})
+	if w != 5 {
+		t.Errorf("EdgeWeight(2, 3) = %v, want 5", w)
+	}
+
+	snap := g.Snapshot()
+	g.Clear()
+	if g.VertexCount() != 0 || snap.VertexCount() != 5 {
+		t.Errorf("Snapshot() = %v, want a copy that survives Clear()", snap)
+	}
+}
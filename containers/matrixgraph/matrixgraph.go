@@ -0,0 +1,182 @@
+// Package matrixgraph provides a graph data structure implemented using an adjacency
+// matrix, which suits dense graphs over the integers 0 to n-1.
+package matrixgraph
+
+import (
+	"iter"
+	"strings"
+
+	"github.com/elordeiro/goext/constraints"
+	"github.com/elordeiro/goext/containers/tuples"
+)
+
+// MatrixGraph is a graph data structure over the vertices 0 to n-1. It is implemented
+// using an n by n matrix that holds the weight of the edge between every pair of
+// vertices, so edge lookups take constant time and the graph takes O(n^2) memory
+// regardless of the number of edges. For unweighted edges, the default weight is set
+// to 1. The graph can be directed or undirected. Passing a vertex outside of the
+// range 0 to n-1 to a method that modifies the graph panics.
+type MatrixGraph[N constraints.Number] struct {
+	n          int
+	weights    []N
+	present    []bool
+	isDirected bool
+}
+
+// New creates a new graph with the vertices 0 to n-1, no edges and the specified
+// directedness.
+func New[N constraints.Number](n int, isDirected bool) *MatrixGraph[N] {
+	return &MatrixGraph[N]{
+		n:          n,
+		weights:    make([]N, n*n),
+		present:    make([]bool, n*n),
+		isDirected: isDirected,
+	}
+}
+
+// FromEdges creates a new graph with the vertices 0 to n-1 and the given edges. If an
+// edge appears more than once, the last weight is kept.
+func FromEdges[N constraints.Number](n int, isDirected bool, edges ...tuples.Edge[int, N]) *MatrixGraph[N] {
+	g := New[N](n, isDirected)
+	for _, e := range edges {
+		g.AddEdge(e.Src(), e.Dst(), e.Weight())
+	}
+	return g
+}
+
+// HasVertex returns true if the vertex is in the range 0 to n-1 and false otherwise.
+func (g MatrixGraph[N]) HasVertex(vertex int) bool {
+	return vertex >= 0 && vertex < g.n
+}
+
+// Degree returns the out-degree of a vertex in a directed graph and the degree of a
+// vertex in an undirected graph. It returns 0 for vertices outside of the graph.
+func (g MatrixGraph[N]) Degree(vertex int) int {
+	degree := 0
+	for range g.Neighbors(vertex) {
+		degree++
+	}
+	return degree
+}
+
+// AddEdge adds an edge between two vertices, src and dst. If the graph is undirected,
+// the edge is added in both directions. If the edge already exists, the weight is
+// updated. If no weight is provided, the default weight is set to 1.
+func (g *MatrixGraph[N]) AddEdge(src, dst int, weight ...N) {
+	var w N = 1
+	if len(weight) > 0 {
+		w = weight[0]
+	}
+	g.set(src, dst, w, true)
+	if !g.isDirected {
+		g.set(dst, src, w, true)
+	}
+}
+
+// RemoveEdge removes the edge between two vertices, src and dst. If the graph is
+// undirected, the edge is removed in both directions. If the edge doesn't exist, it
+// is a no-op.
+func (g *MatrixGraph[N]) RemoveEdge(src, dst int) {
+	g.set(src, dst, 0, false)
+	if !g.isDirected {
+		g.set(dst, src, 0, false)
+	}
+}
+
+// set sets the weight and the presence of the edge from src to dst.
+func (g *MatrixGraph[N]) set(src, dst int, w N, present bool) {
+	if !g.HasVertex(src) || !g.HasVertex(dst) {
+		panic("vertex out of range")
+	}
+	g.weights[src*g.n+dst] = w
+	g.present[src*g.n+dst] = present
+}
+
+// HasEdge returns true if there is an edge between two vertices, src and dst, and
+// false otherwise. If a weight is provided, it also checks that the edge has that
+// weight.
+func (g MatrixGraph[N]) HasEdge(src, dst int, weight ...N) bool {
+	w, ok := g.EdgeWeight(src, dst)
+	return ok && (len(weight) == 0 || w == weight[0])
+}
+
+// EdgeWeight returns the weight of the edge between two vertices, src and dst, and
+// true, or false if the edge doesn't exist.
+func (g MatrixGraph[N]) EdgeWeight(src, dst int) (N, bool) {
+	if !g.HasVertex(src) || !g.HasVertex(dst) || !g.present[src*g.n+dst] {
+		return 0, false
+	}
+	return g.weights[src*g.n+dst], true
+}
+
+// VertexCount returns the number of vertices in the graph.
+func (g MatrixGraph[N]) VertexCount() int {
+	return g.n
+}
+
+// IsDirected returns true if the graph is directed and false otherwise.
+func (g MatrixGraph[N]) IsDirected() bool {
+	return g.isDirected
+}
+
+// Edges returns an iter.Seq[tuples.Edge[int, N]] over the edges of the graph. In
+// undirected graphs every edge is yielded once, from its smaller end.
+func (g MatrixGraph[N]) Edges() iter.Seq[tuples.Edge[int, N]] {
+	return func(yield func(tuples.Edge[int, N]) bool) {
+		for src := range g.n {
+			for dst, w := range g.Neighbors(src) {
+				if !g.isDirected && dst < src {
+					continue
+				}
+				if !yield(tuples.NewEdge(src, dst, w)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Vertices returns an iter.Seq[int] over the vertices 0 to n-1.
+func (g MatrixGraph[N]) Vertices() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for v := range g.n {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbors returns an iter.Seq2[int, N] over the neighbors of a vertex along with
+// the weights of the edges, in increasing order. If the vertex is outside of the
+// graph, an empty sequence is returned.
+func (g MatrixGraph[N]) Neighbors(vertex int) iter.Seq2[int, N] {
+	return func(yield func(int, N) bool) {
+		if !g.HasVertex(vertex) {
+			return
+		}
+		row := vertex * g.n
+		for dst := range g.n {
+			if g.present[row+dst] && !yield(dst, g.weights[row+dst]) {
+				return
+			}
+		}
+	}
+}
+
+// String returns a string representation of the graph.
+func (g MatrixGraph[N]) String() string {
+	var sb strings.Builder
+	sb.WriteString("G[")
+	first := true
+	for e := range g.Edges() {
+		if first {
+			first = false
+		} else {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(e.String())
+	}
+	sb.WriteString("]")
+	return sb.String()
+}
@@ -0,0 +1,71 @@
+package matrixgraph_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/elordeiro/goext/containers/graph"
+	"github.com/elordeiro/goext/containers/matrixgraph"
+	"github.com/elordeiro/goext/containers/tuples"
+	"github.com/elordeiro/goext/seqs"
+)
+
+func TestMatrixGraph(t *testing.T) {
+	g := matrixgraph.FromEdges(4, false,
+		tuples.NewEdge(0, 1, 2),
+		tuples.NewEdge(1, 2, 3),
+		tuples.NewEdge(2, 2, 1),
+		tuples.NewEdge(0, 1, 5),
+	)
+
+	if got := g.VertexCount(); got != 4 {
+		t.Errorf("VertexCount() = %v, want 4", got)
+	}
+	if !g.HasEdge(1, 0, 5) || g.HasEdge(0, 1, 2) || g.HasEdge(0, 3) {
+		t.Errorf("HasEdge() does not match the edges")
+	}
+	if w, ok := g.EdgeWeight(2, 1); !ok || w != 3 {
+		t.Errorf("EdgeWeight(2, 1) = %v, %v, want 3, true", w, ok)
+	}
+	if _, ok := g.EdgeWeight(0, 9); ok {
+		t.Errorf("EdgeWeight(0, 9) = _, true, want false")
+	}
+	if got := g.Degree(1); got != 2 {
+		t.Errorf("Degree(1) = %v, want 2", got)
+	}
+	if got, want := g.String(), "G[(0->1 5) (1->2 3) (2->2 1)]"; got != want {
+		t.Errorf("String() = %v, want %v", got, want)
+	}
+
+	g.RemoveEdge(2, 1)
+	if g.HasEdge(1, 2) || g.HasEdge(2, 1) {
+		t.Errorf("RemoveEdge(2, 1) left the edge in the graph")
+	}
+	if got := seqs.Len(g.Edges()); got != 2 {
+		t.Errorf("len(Edges()) = %v, want 2", got)
+	}
+}
+
+func TestMatrixGraphDirected(t *testing.T) {
+	g := matrixgraph.New[int](3, true)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2, 4)
+
+	if g.HasEdge(1, 0) || !g.HasEdge(0, 1, 1) {
+		t.Errorf("AddEdge() did not add a single directed edge")
+	}
+	var got []int
+	for e := range graph.BFS(g, 0) {
+		got = append(got, e.Dst())
+	}
+	if !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("BFS(0) visited %v, want [1 2]", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("AddEdge(0, 3) did not panic")
+		}
+	}()
+	g.AddEdge(0, 3)
+}
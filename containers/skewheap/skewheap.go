@@ -35,9 +35,16 @@ func Push[V any, N constraints.Number](heap *SkewHeap[V, N], cost N, item V) *Sk
 }
 
 func Pop[V any, N constraints.Number](heap *SkewHeap[V, N]) *SkewHeap[V, N] {
+	if heap.offset != 0 {
+		propagate(heap)
+	}
 	return Merge(heap.left, heap.right)
 }
 
+func (heap *SkewHeap[V, N]) Cost() N {
+	return heap.cost
+}
+
 func Update[V any, N constraints.Number](heap *SkewHeap[V, N], offset N) {
 	if heap == nil {
 		return
@@ -0,0 +1,68 @@
+package skewheap_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/elordeiro/goext/containers/skewheap"
+)
+
+// drain pops every item of the heap and returns their costs and items in order
+func drain(heap *skewheap.SkewHeap[string, int]) ([]int, []string) {
+	var costs []int
+	var items []string
+	for heap != nil {
+		costs = append(costs, heap.Cost())
+		items = append(items, heap.Item)
+		heap = skewheap.Pop(heap)
+	}
+	return costs, items
+}
+
+func TestPushPop(t *testing.T) {
+	var heap *skewheap.SkewHeap[string, int]
+	for i, cost := range []int{5, 3, 8, 1, 4} {
+		heap = skewheap.Push(heap, cost, string(rune('a'+i)))
+	}
+
+	costs, items := drain(heap)
+	if !slices.Equal(costs, []int{1, 3, 4, 5, 8}) || !slices.Equal(items, []string{"d", "b", "e", "a", "c"}) {
+		t.Errorf("drain() = %v, %v, want [1 3 4 5 8], [d b e a c]", costs, items)
+	}
+}
+
+func TestPopAfterUpdate(t *testing.T) {
+	var heap *skewheap.SkewHeap[string, int]
+	for i, cost := range []int{5, 3, 8, 1, 4} {
+		heap = skewheap.Push(heap, cost, string(rune('a'+i)))
+	}
+
+	// the offset is added lazily, and must reach every item as they are popped
+	skewheap.Update(heap, 10)
+	if got := heap.Cost(); got != 11 {
+		t.Errorf("Cost() = %v after Update(10), want 11", got)
+	}
+	heap = skewheap.Pop(heap)
+	skewheap.Update(heap, -3)
+	heap = skewheap.Push(heap, 9, "f")
+
+	costs, items := drain(heap)
+	if !slices.Equal(costs, []int{9, 10, 11, 12, 15}) || !slices.Equal(items, []string{"f", "b", "e", "a", "c"}) {
+		t.Errorf("drain() = %v, %v, want [9 10 11 12 15], [f b e a c]", costs, items)
+	}
+}
+
+func TestMergeAfterUpdate(t *testing.T) {
+	var h1, h2 *skewheap.SkewHeap[string, int]
+	h1 = skewheap.Push(h1, 1, "a")
+	h1 = skewheap.Push(h1, 6, "b")
+	h2 = skewheap.Push(h2, 2, "c")
+	h2 = skewheap.Push(h2, 3, "d")
+	skewheap.Update(h1, 1)
+	skewheap.Update(h2, 5)
+
+	costs, items := drain(skewheap.Merge(h1, h2))
+	if !slices.Equal(costs, []int{2, 7, 7, 8}) || items[0] != "a" || items[3] != "d" {
+		t.Errorf("drain() = %v, %v, want [2 7 7 8] from a to d", costs, items)
+	}
+}
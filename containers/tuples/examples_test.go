@@ -72,6 +72,18 @@ func ExampleEdge_Weight() {
 	// Output: 5
 }
 
+func ExampleNewCostEdge() {
+	edge := tuples.NewCostEdge("A", "B", 5, 2)
+	fmt.Println(edge)
+	// Output: (A->B 5 $2)
+}
+
+func ExampleCostEdge_Cost() {
+	edge := tuples.NewCostEdge("A", "B", 5, 2)
+	fmt.Println(edge.Cost())
+	// Output: 2
+}
+
 func ExampleNewCell() {
 	cell := tuples.NewCell(1, 2)
 	fmt.Println(cell)
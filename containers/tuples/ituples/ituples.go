@@ -16,6 +16,12 @@ type Edge[V comparable, N constraints.Number] interface {
 	Weight() N
 }
 
+// CostEdge is an interface for a weighted edge with a cost.
+type CostEdge[V comparable, N constraints.Number] interface {
+	Edge[V, N]
+	Cost() N
+}
+
 // Cell is an interface for a grid cell.
 type Cell[N constraints.Number] interface {
 	Row() N
@@ -72,6 +72,34 @@ func TestEdges(t *testing.T) {
 	}
 }
 
+func TestNewCostEdge(t *testing.T) {
+	edge := tuples.NewCostEdge("A", "B", 5, 2)
+
+	if edge.Src() != "A" || edge.Dst() != "B" {
+		t.Errorf("Src(), Dst() = %v, %v, want A, B", edge.Src(), edge.Dst())
+	}
+
+	if edge.Weight() != 5 {
+		t.Errorf("Weight() = %v, want 5", edge.Weight())
+	}
+
+	if edge.Cost() != 2 {
+		t.Errorf("Cost() = %v, want 2", edge.Cost())
+	}
+}
+
+func TestCostEdges(t *testing.T) {
+	want := []tuples.CostEdge[int, int]{tuples.NewCostEdge(1, 2, 1, 3), tuples.NewCostEdge(3, 4, 1, -2)}
+	got := tuples.CostEdges(
+		[4]int{1, 2, 1, 3},
+		[4]int{3, 4, 1, -2},
+	)
+
+	if !slices.Equal(got, want) {
+		t.Errorf("CostEdges() = %v, want %v", got, want)
+	}
+}
+
 func TestNewCell(t *testing.T) {
 	cell := tuples.NewCell(1, 2)
 
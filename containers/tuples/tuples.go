@@ -93,6 +93,44 @@ func (e Edge[V, N]) String() string {
 	return fmt.Sprintf("(%v->%v %v)", e.src, e.dst, e.weight)
 }
 
+// ---- CostEdge ----
+
+// CostEdge is an Edge that also carries a cost. It represents an edge of a flow
+// network, where the weight is the capacity of the edge and the cost is the price
+// of sending one unit of flow through it.
+type CostEdge[V comparable, N constraints.Number] struct {
+	Edge[V, N]
+	cost N
+}
+
+// NewCostEdge returns a new edge with the given source and destination vertices,
+// weight and cost.
+func NewCostEdge[V comparable, N constraints.Number](src, dst V, weight, cost N) CostEdge[V, N] {
+	return CostEdge[V, N]{Edge[V, N]{src, dst, weight}, cost}
+}
+
+// CostEdges returns a new slice of CostEdges with the given edges.
+// Note that the edges are represented as arrays of 4 elements, where the first two
+// elements are the source and destination vertices, the third element is the weight
+// and the fourth element is the cost.
+func CostEdges[N constraints.Number](edges ...[4]N) []CostEdge[N, N] {
+	var slice []CostEdge[N, N]
+	for _, edge := range edges {
+		slice = append(slice, NewCostEdge(edge[0], edge[1], edge[2], edge[3]))
+	}
+	return slice
+}
+
+// Cost returns the cost of an edge
+func (e CostEdge[V, N]) Cost() N {
+	return e.cost
+}
+
+// String returns a string representation of an edge with a cost.
+func (e CostEdge[V, N]) String() string {
+	return fmt.Sprintf("(%v->%v %v $%v)", e.src, e.dst, e.weight, e.cost)
+}
+
 // ---- Cell ----
 
 // Cell is a pair of numbers that represents a coordinate on a 2D grid.
@@ -115,3 +115,11 @@ func Inf[N constraints.Number]() N {
 		panic("unsupported type")
 	}
 }
+
+// IsIntegral returns true if N is an integer type and false if it is a floating-point
+// type.
+func IsIntegral[N constraints.Number]() bool {
+	// converting a non-constant 0.5 truncates it to 0 for integer types only
+	half := 0.5
+	return N(half) == 0
+}
@@ -62,3 +62,12 @@ func TestMax(t *testing.T) {
 		}
 	}
 }
+
+func TestIsIntegral(t *testing.T) {
+	if !math.IsIntegral[int]() || !math.IsIntegral[uint8]() {
+		t.Errorf("IsIntegral() = false for an integer type, want true")
+	}
+	if math.IsIntegral[float64]() || math.IsIntegral[float32]() {
+		t.Errorf("IsIntegral() = true for a floating-point type, want false")
+	}
+}